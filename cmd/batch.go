@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/batch"
+	"jiraflow/internal/branch"
+	"jiraflow/internal/config"
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+	"jiraflow/internal/history"
+	"jiraflow/internal/jira"
+	"jiraflow/internal/tui"
+	"jiraflow/internal/undo"
+)
+
+var (
+	// Batch command flags
+	batchFile  string
+	batchJQL   string
+	batchLimit int
+	batchType  string
+	batchBase  string
+	batchYes   bool
+)
+
+// batchCmd creates branches for several tickets at once
+var batchCmd = &cobra.Command{
+	Use:   "batch [ticket...]",
+	Short: "Create branches for several tickets at once",
+	Long: `Create a branch for every ticket of a list, e.g. at sprint kickoff.
+
+Tickets are read from the arguments, from a file (--file), from standard input
+(-) and from a Jira JQL query (--jql); the sources can be combined. Lines have
+the form "KEY title", and the output of jira issue list --plain is understood.
+Titles that are not given are fetched from Jira.
+
+Branch names are generated as in non-interactive mode and shown for review:
+select the branches to create, or pass --yes to create all of them. Branches
+are created from the base branch without checking them out; branches that
+already exist are left alone. A summary lists the outcome per ticket, and the
+command exits with status 1 if any branch could not be created.
+
+Examples:
+  # Create feature branches from develop for three tickets
+  jiraflow batch --base develop PROJ-1 PROJ-2 PROJ-3
+
+  # Create branches for the open tickets of the current sprint without review
+  jiraflow batch --jql 'sprint in openSprints() AND assignee = currentUser()' --yes
+
+  # Read "KEY title" lines from a file, or from stdin
+  jiraflow batch --file sprint.txt --type bugfix
+  pbpaste | jiraflow batch - --yes
+
+  # Only show the branch names
+  jiraflow batch --dry-run PROJ-1 PROJ-2`,
+	ValidArgsFunction: completeTickets,
+	SilenceUsage:      true,
+	RunE:              runBatch,
+}
+
+func init() {
+	batchCmd.Flags().StringVarP(&batchFile, "file", "f", "", "Read \"KEY title\" lines from a file")
+	batchCmd.Flags().StringVar(&batchJQL, "jql", "", "Add the tickets matching a Jira JQL query")
+	batchCmd.Flags().IntVar(&batchLimit, "limit", 50, "Maximum number of tickets taken from --jql")
+	batchCmd.Flags().StringVarP(&batchType, "type", "t", "", "Branch type of all branches (defaults to default_branch_type)")
+	batchCmd.Flags().StringVarP(&batchBase, "base", "b", "", "Base branch to create the branches from (defaults to current branch)")
+	batchCmd.Flags().BoolVarP(&batchYes, "yes", "y", false, "Create all branches without review")
+	_ = batchCmd.RegisterFlagCompletionFunc("type", completeBranchTypes)
+	_ = batchCmd.RegisterFlagCompletionFunc("base", completeBaseBranches)
+	_ = batchCmd.RegisterFlagCompletionFunc("jql", cobra.NoFileCompletions)
+	rootCmd.AddCommand(batchCmd)
+}
+
+// runBatch is the entry point for the batch command
+func runBatch(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return errNotGitRepository
+	}
+
+	if batchType == "" {
+		batchType = cfg.DefaultBranchType
+	}
+	if _, ok := cfg.BranchTypes[batchType]; !ok {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("invalid branch type '%s'\n  Valid types: %s", batchType, strings.Join(cfg.VisibleBranchTypes(), ", ")))
+	}
+
+	base, err := batchBaseBranch(gitRepo)
+	if err != nil {
+		return err
+	}
+
+	jiraClient := newJiraClient(cfg)
+	tickets, err := batchTickets(args, jiraClient)
+	if err != nil {
+		return err
+	}
+	if len(tickets) == 0 {
+		return errors.NewUsageError(cmd.CommandPath(), "no tickets given\n  Pass ticket keys as arguments, or use --file, --jql or - to read them from stdin")
+	}
+	fetchMissingTitles(tickets, jiraClient)
+
+	existing, err := gitRepo.GetLocalBranches()
+	if err != nil {
+		return fmt.Errorf("failed to list local branches: %w", err)
+	}
+
+	generator := branch.NewBranchGenerator(branch.NewBranchSanitizer())
+	generatorConfig := newGeneratorConfig(cfg)
+	items := batch.Plan(tickets, existing, func(ticket jira.Ticket) string {
+		return generator.GenerateNameWithConfig(branch.BranchInfo{
+			Type:     batchType,
+			TicketID: ticket.Key,
+			Title:    ticket.Summary,
+		}, generatorConfig)
+	})
+
+	if dryRun {
+		fmt.Printf("Branches that would be created from '%s':\n", base)
+		printBatchItems(items)
+		return nil
+	}
+
+	if !batchYes && batch.Count(items, batch.StatusPending) > 0 {
+		if !isTerminal(os.Stdout) {
+			printBatchItems(items)
+			return errors.NewUsageError(cmd.CommandPath(), "review requires a terminal; pass --yes to create all branches")
+		}
+		if items, err = tui.RunBatchReview(items); err != nil {
+			return err
+		}
+	}
+
+	operation := undo.Begin(gitRepo, "batch")
+	failed := batch.Create(gitRepo, items, base)
+	for _, item := range items {
+		if item.Status == batch.StatusCreated {
+			operation.AddBranch(gitRepo, item.Branch, base)
+			recordHistory(gitRepo, history.Entry{
+				Type:   batchType,
+				Base:   base,
+				Ticket: item.Ticket,
+				Title:  item.Title,
+				Branch: item.Branch,
+			})
+		}
+	}
+	_ = undo.Record(gitRepo, operation)
+	fmt.Printf("Branches from '%s':\n", base)
+	printBatchItems(items)
+	fmt.Printf("\n%d created, %d failed, %d skipped\n",
+		batch.Count(items, batch.StatusCreated), failed, len(items)-batch.Count(items, batch.StatusCreated)-failed)
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d branch(es) could not be created", failed, len(items))
+	}
+	return nil
+}
+
+// batchBaseBranch returns the --base branch after checking that it exists, or the current branch
+func batchBaseBranch(gitRepo git.GitRepository) (string, error) {
+	if batchBase == "" {
+		current, err := gitRepo.GetCurrentBranch()
+		if err != nil {
+			return "", fmt.Errorf("failed to get current branch (use --base): %w", err)
+		}
+		return current, nil
+	}
+
+	if err := checkBaseBranch(gitRepo, batchBase); err != nil {
+		return "", err
+	}
+	return batchBase, nil
+}
+
+// batchTickets collects the tickets from the arguments, standard input for "-", --file and --jql
+func batchTickets(args []string, jiraClient jira.JiraClient) ([]jira.Ticket, error) {
+	var tickets []jira.Ticket
+	for _, arg := range args {
+		if arg == "-" {
+			read, err := jira.ParseTicketList(os.Stdin)
+			if err != nil {
+				return nil, err
+			}
+			tickets = append(tickets, read...)
+			continue
+		}
+
+		ticket, ok := jira.ParseTicketLine(arg)
+		if !ok || !ticketKeyRegex.MatchString(ticket.Key) {
+			return nil, fmt.Errorf("invalid ticket '%s'\n  Expected format: PROJECT-NUMBER (e.g., PROJ-123)", arg)
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	if batchFile != "" {
+		file, err := os.Open(config.ExpandHome(batchFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open ticket list: %w", err)
+		}
+		defer file.Close()
+
+		read, err := jira.ParseTicketList(file)
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, read...)
+	}
+
+	if batchJQL != "" {
+		found, err := jiraClient.SearchTickets(batchJQL, batchLimit)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			fmt.Printf("No tickets match the query '%s'\n", batchJQL)
+		}
+		tickets = append(tickets, found...)
+	}
+
+	return tickets, nil
+}
+
+// fetchMissingTitles fetches the titles that were not given from Jira
+// Tickets whose title cannot be fetched keep an empty title and a warning is shown
+func fetchMissingTitles(tickets []jira.Ticket, jiraClient jira.JiraClient) {
+	missing := 0
+	for _, ticket := range tickets {
+		if ticket.Summary == "" {
+			missing++
+		}
+	}
+	if missing == 0 {
+		return
+	}
+	if !jiraClient.IsAvailable() {
+		fmt.Printf("Warning: Jira CLI not available, %d branch(es) are named without a title\n", missing)
+		return
+	}
+
+	for i := range tickets {
+		if tickets[i].Summary != "" {
+			continue
+		}
+		title, err := jiraClient.GetTicketTitle(tickets[i].Key)
+		if err != nil {
+			fmt.Printf("Warning: Could not fetch title of %s from Jira: %v\n", tickets[i].Key, err)
+			continue
+		}
+		tickets[i].Summary = title
+	}
+}
+
+// printBatchItems prints one line per ticket with its branch and status
+func printBatchItems(items []batch.Item) {
+	ticketWidth := 0
+	for _, item := range items {
+		ticketWidth = max(ticketWidth, len(item.Ticket))
+	}
+
+	for _, item := range items {
+		marker := " "
+		switch item.Status {
+		case batch.StatusCreated:
+			marker = "✓"
+		case batch.StatusFailed:
+			marker = "✗"
+		case batch.StatusExists, batch.StatusDuplicate, batch.StatusSkipped:
+			marker = "-"
+		}
+
+		line := fmt.Sprintf("%s %-*s  %s", marker, ticketWidth, item.Ticket, item.Branch)
+		switch {
+		case item.Message != "":
+			line += fmt.Sprintf(" (%s)", item.Message)
+		case item.Status == batch.StatusSkipped:
+			line += " (skipped)"
+		}
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/branch"
+	"jiraflow/internal/commit"
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+	"jiraflow/internal/tui"
+)
+
+var (
+	// Commit command flags
+	commitMessage string
+	commitAll     bool
+)
+
+// commitCmd commits with a message derived from the ticket of the current branch
+var commitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "Commit with a message that references the ticket of the current branch",
+	Long: `Commit the staged changes with a message that references the Jira ticket
+of the current branch.
+
+The current branch is parsed into branch type and ticket key. Without -m a
+composer opens with a Conventional Commits message: the commit type is derived
+from the branch type (commit.types), the subject is prefilled with the Jira
+summary, and the ticket is named in a footer such as "Refs: PROJ-123"
+(commit.ticket_footer). Edit type, scope, subject and body, then commit.
+
+With -m the message is written into commit.template instead, "{ticket}: {message}"
+by default, unless it already mentions the ticket key.
+
+Examples:
+  # Compose the message interactively
+  jiraflow commit
+
+  # Commit all tracked changes as "PROJ-123: Fix typo"
+  jiraflow commit -a -m "Fix typo"
+
+  # Show the message without committing
+  jiraflow commit -m "Fix typo" --dry-run`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runCommit,
+}
+
+func init() {
+	commitCmd.Flags().StringVarP(&commitMessage, "message", "m", "", "Commit message to write into commit.template, skipping the composer")
+	commitCmd.Flags().BoolVarP(&commitAll, "all", "a", false, "Commit all modified tracked files, like git commit -a")
+	rootCmd.AddCommand(commitCmd)
+}
+
+// runCommit is the entry point for the commit command
+func runCommit(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return errNotGitRepository
+	}
+
+	if !commitAll {
+		staged, err := gitRepo.HasStagedChanges()
+		if err != nil {
+			return err
+		}
+		if !staged {
+			return errors.NewGitError("commit", "no changes staged for commit (use git add or --all)", true)
+		}
+	}
+
+	// Recover branch type and ticket from the current branch name
+	currentBranch, err := gitRepo.GetCurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+	parser := branch.NewBranchParser(branch.ParserConfigFromAppConfig(cfg.BranchTypes, cfg.Sanitization.Separator))
+	parsed, err := parser.Parse(currentBranch)
+	if err != nil {
+		fmt.Printf("Warning: no ticket found in branch '%s', the message will not reference a ticket\n", currentBranch)
+	}
+	commitType := commit.TypeForBranch(parsed.Type, cfg.Commit.Types)
+
+	var message string
+	if cmd.Flags().Changed("message") {
+		if strings.TrimSpace(commitMessage) == "" {
+			return fmt.Errorf("commit message cannot be empty")
+		}
+		message = commit.ApplyTemplate(cfg.Commit.Template, commitMessage, parsed.TicketID, commitType)
+	} else {
+		draft := commit.Message{
+			Type:        commitType,
+			Ticket:      parsed.TicketID,
+			FooterToken: cfg.Commit.TicketFooter,
+		}
+		if parsed.TicketID != "" {
+			jiraClient := newJiraClient(cfg)
+			if jiraClient.IsAvailable() {
+				if summary, err := jiraClient.GetTicketTitle(parsed.TicketID); err == nil {
+					draft.Subject = summary
+				} else {
+					fmt.Printf("Warning: Could not fetch title from Jira: %v\n", err)
+				}
+			}
+		}
+
+		composed, err := tui.RunCommitComposer(draft, commit.Types)
+		if err != nil {
+			return err
+		}
+		message = composed.String()
+	}
+
+	if dryRun {
+		fmt.Printf("Commit message:\n\n%s\n", strings.TrimRight(message, "\n"))
+		fmt.Println("\n✓ Dry-run complete, nothing was committed")
+		return nil
+	}
+
+	return gitRepo.Commit(message, commitAll)
+}
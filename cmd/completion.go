@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/branch"
+	"jiraflow/internal/config"
+	"jiraflow/internal/git"
+	"jiraflow/internal/jira"
+)
+
+// completionCmd prints shell completion scripts
+var completionCmd = &cobra.Command{
+	Use:   "completion bash|zsh|fish",
+	Short: "Print the shell completion script",
+	Long: `Print the completion script for bash, zsh or fish.
+
+Besides commands and flags, the script completes values: --type offers the
+configured branch types, --base the local and remote branches, and --ticket
+the tickets fetched from Jira before and those of local branches, with the
+ticket summary as description.
+
+Load the completions in the current shell:
+  source <(jiraflow completion bash)
+  source <(jiraflow completion zsh)
+  jiraflow completion fish | source
+
+Load them for every new shell:
+  # bash (requires the bash-completion package)
+  jiraflow completion bash > ~/.local/share/bash-completion/completions/jiraflow
+
+  # zsh (any directory in $fpath)
+  jiraflow completion zsh > "${fpath[1]}/_jiraflow"
+
+  # fish
+  jiraflow completion fish > ~/.config/fish/completions/jiraflow.fish`,
+	ValidArgs:             []string{"bash", "zsh", "fish"},
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	DisableFlagsInUseLine: true,
+	SilenceUsage:          true,
+	RunE:                  runCompletion,
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
+
+// runCompletion is the entry point for the completion command
+func runCompletion(cmd *cobra.Command, args []string) error {
+	switch args[0] {
+	case "bash":
+		return rootCmd.GenBashCompletionV2(os.Stdout, true)
+	case "zsh":
+		return rootCmd.GenZshCompletion(os.Stdout)
+	default:
+		return rootCmd.GenFishCompletion(os.Stdout, true)
+	}
+}
+
+// completionConfig loads the configuration for completion without printing warnings,
+// which would end up in the completion output; on errors the defaults are used
+func completionConfig() *config.Config {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return config.GetDefaultConfig()
+	}
+	cfg, _, err := configManager.LoadStrict()
+	if err != nil {
+		return config.GetDefaultConfig()
+	}
+	return cfg
+}
+
+// completeBranchTypes completes --type with the visible branch types and their descriptions
+func completeBranchTypes(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg := completionConfig()
+
+	var completions []string
+	for _, key := range cfg.VisibleBranchTypes() {
+		if strings.HasPrefix(key, toComplete) {
+			completions = append(completions, key+"\t"+cfg.TypeOptionsFor(key).Description)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeBaseBranches completes --base with the local branches and the remote branches
+// that have no local counterpart
+func completeBaseBranches(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	localBranches, err := gitRepo.GetBranchesWithInfo()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []string
+	localNames := make(map[string]bool)
+	for _, info := range localBranches {
+		localNames[info.Name] = true
+		if !strings.HasPrefix(info.Name, toComplete) {
+			continue
+		}
+		description := "local branch"
+		if info.IsCurrent {
+			description = "current branch"
+		}
+		completions = append(completions, info.Name+"\t"+description)
+	}
+
+	if remoteBranches, err := gitRepo.GetRemoteBranches(); err == nil {
+		for _, name := range remoteBranches {
+			if _, localName := git.SplitRemoteBranch(name); localNames[localName] || !strings.HasPrefix(name, toComplete) {
+				continue
+			}
+			completions = append(completions, name+"\tremote branch")
+		}
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTickets completes ticket keys with the cached Jira tickets, most recent first,
+// followed by the tickets of local branches
+func completeTickets(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	tickets := cachedTickets()
+	tickets = appendTickets(tickets, branchTickets(completionConfig(), tickets)...)
+	return ticketCompletions(tickets, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeBranchTicket completes the ticket argument of switch with the tickets of local branches
+func completeBranchTicket(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return ticketCompletions(branchTickets(completionConfig(), cachedTickets()), nil, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// cachedTickets returns the tickets fetched from Jira before, most recently fetched first
+func cachedTickets() []jira.Ticket {
+	cached, err := jira.NewTicketCache(config.TicketCachePath(config.StateDir())).Load()
+	if err != nil {
+		return nil
+	}
+
+	tickets := make([]jira.Ticket, len(cached))
+	for i, ticket := range cached {
+		tickets[i] = ticket.Ticket
+	}
+	return tickets
+}
+
+// branchTickets returns the tickets of the local branches, with the summary taken from known
+// tickets or, failing that, the branch name
+func branchTickets(cfg *config.Config, known []jira.Ticket) []jira.Ticket {
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return nil
+	}
+	names, err := gitRepo.GetLocalBranches()
+	if err != nil {
+		return nil
+	}
+
+	summaries := make(map[string]string, len(known))
+	for _, ticket := range known {
+		summaries[ticket.Key] = ticket.Summary
+	}
+
+	parser := branch.NewBranchParser(branch.ParserConfigFromAppConfig(cfg.BranchTypes, cfg.Sanitization.Separator))
+	var tickets []jira.Ticket
+	for _, name := range names {
+		parsed, err := parser.Parse(name)
+		if err != nil {
+			continue
+		}
+		summary := summaries[parsed.TicketID]
+		if summary == "" {
+			summary = name
+		}
+		tickets = appendTickets(tickets, jira.Ticket{Key: parsed.TicketID, Summary: summary})
+	}
+	return tickets
+}
+
+// appendTickets appends the tickets whose keys are not in the list yet
+func appendTickets(tickets []jira.Ticket, more ...jira.Ticket) []jira.Ticket {
+	seen := make(map[string]bool, len(tickets))
+	for _, ticket := range tickets {
+		seen[ticket.Key] = true
+	}
+	for _, ticket := range more {
+		if !seen[ticket.Key] {
+			seen[ticket.Key] = true
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets
+}
+
+// ticketCompletions formats the tickets starting with toComplete as completions with
+// the summary as description, leaving out tickets that were already given
+func ticketCompletions(tickets []jira.Ticket, given []string, toComplete string) []string {
+	skip := make(map[string]bool, len(given))
+	for _, arg := range given {
+		skip[strings.ToUpper(arg)] = true
+	}
+
+	prefix := strings.ToUpper(toComplete)
+	var completions []string
+	for _, ticket := range tickets {
+		if skip[ticket.Key] || !strings.HasPrefix(ticket.Key, prefix) {
+			continue
+		}
+		completions = append(completions, fmt.Sprintf("%s\t%s", ticket.Key, ticket.Summary))
+	}
+	return completions
+}
@@ -0,0 +1,551 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"jiraflow/internal/config"
+	"jiraflow/internal/errors"
+)
+
+var (
+	// Config command flags
+	configShowFormat string
+	configRepo       bool
+	configInitForce  bool
+)
+
+// configCmd groups the configuration subcommands
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and edit JiraFlow configuration",
+	Long: `Inspect and edit the JiraFlow configuration.
+
+Configuration files are merged in this order, later files overriding earlier ones:
+  1. /etc/jiraflow/jiraflow.yaml      (system-wide, optional)
+  2. ~/.config/jiraflow/jiraflow.yaml (user)
+  3. <repository root>/.jiraflow.yaml (repository-local, optional)
+
+A file can name a shared file with "extends: <path>" or
+"extends: <ref>:<path>" (a file at a Git revision); the shared file is
+merged just below it. Keys listed under "locked:" cannot be changed by
+anything applied later.
+
+The active profile (--profile, JIRAFLOW_PROFILE or matched automatically)
+is applied on top of the files. JIRAFLOW_* environment variables and
+--set key=value flags override both.
+
+Commands that modify configuration (set, edit) write the user file, or the
+repository file with --repo. Changes are validated before they are saved.`,
+}
+
+// configShowCmd prints the effective configuration
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the effective merged configuration",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigShow,
+}
+
+// configPathCmd prints the path of the configuration file that set and edit modify
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the path of the configuration file",
+	Long: `Print the path of the user configuration file, or of the repository
+configuration file with --repo.
+
+Example:
+  $EDITOR "$(jiraflow config path)"`,
+	Args: cobra.NoArgs,
+	RunE: runConfigPath,
+}
+
+// configGetCmd prints a single effective configuration value
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the effective value of a configuration key",
+	Long: `Print the effective value of a configuration key.
+
+Keys use dots for nested settings and map entries.
+
+Examples:
+  jiraflow config get max_branch_length
+  jiraflow config get sanitization.separator
+  jiraflow config get branch_types.feature`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigGet,
+}
+
+// configSetCmd sets a single value in a configuration file
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a configuration value in the user or repository file",
+	Long: `Set a configuration value in the user configuration file, or in the
+repository configuration file with --repo. Comments and other settings in the
+file are kept. The change is only saved if the resulting configuration is valid.
+
+Lists are comma separated and maps are written as key=value pairs.
+
+Examples:
+  jiraflow config set max_branch_length 50
+  jiraflow config set branch_types.bugfix bugfix/
+  jiraflow config set --repo sanitization.separator _`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSet,
+}
+
+// configEditCmd opens a configuration file in the user's editor
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit the configuration file in $EDITOR",
+	Long: `Open the user configuration file, or the repository configuration file
+with --repo, in $VISUAL or $EDITOR. The edited file is validated before it is
+saved; if it is invalid you can re-open the editor or discard the changes.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigEdit,
+}
+
+// configValidateCmd reports every problem in the effective configuration
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration and report all errors",
+	Long: `Check the effective configuration without correcting any values and
+report every error found. Exits with status 2 if the configuration is invalid.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runConfigValidate,
+}
+
+// configInitCmd writes the default user configuration file
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create the default user configuration file",
+	Long: `Create the user configuration file with default values.
+An existing file is only replaced with --force.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigInit,
+}
+
+// configMigrateCmd upgrades configuration files written for an older version
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate [file...]",
+	Short: "Upgrade configuration files to the current version",
+	Long: `Upgrade configuration files written for an older JiraFlow to the current
+configuration version. The original of every upgraded file is kept next to it,
+e.g. jiraflow.yaml.v0.bak.
+
+Without arguments the user configuration file is upgraded, or the repository
+configuration file with --repo. Outdated files are otherwise only upgraded in
+memory when they are loaded, with a warning.
+
+Examples:
+  jiraflow config migrate
+  jiraflow config migrate --repo
+  jiraflow config migrate /etc/jiraflow/jiraflow.yaml`,
+	RunE: runConfigMigrate,
+}
+
+// configSchemaCmd prints the JSON Schema for configuration files
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema for configuration files",
+	Long: `Print the JSON Schema describing jiraflow.yaml files. Editors with YAML
+language support use it for validation and autocompletion.
+
+Example:
+  jiraflow config schema > ~/.config/jiraflow/jiraflow.schema.json`,
+	Args: cobra.NoArgs,
+	RunE: runConfigSchema,
+}
+
+// configSourcesCmd shows which file each effective configuration value came from
+var configSourcesCmd = &cobra.Command{
+	Use:   "sources",
+	Short: "Show which file each configuration value came from",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigSources,
+}
+
+func init() {
+	configShowCmd.Flags().StringVar(&configShowFormat, "format", "yaml", "Output format (yaml, json)")
+	for _, cmd := range []*cobra.Command{configPathCmd, configSetCmd, configEditCmd, configMigrateCmd} {
+		cmd.Flags().BoolVar(&configRepo, "repo", false, "Use the repository configuration file (.jiraflow.yaml)")
+	}
+	configInitCmd.Flags().BoolVar(&configInitForce, "force", false, "Overwrite an existing configuration file")
+
+	configCmd.AddCommand(configShowCmd)
+	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configEditCmd)
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configInitCmd)
+	configCmd.AddCommand(configMigrateCmd)
+	configCmd.AddCommand(configSchemaCmd)
+	configCmd.AddCommand(configSourcesCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// configLayer returns the configuration layer selected by the --repo flag
+func configLayer() string {
+	if configRepo {
+		return config.LayerRepo
+	}
+	return config.LayerUser
+}
+
+// runConfigShow prints the effective configuration as YAML or JSON
+func runConfigShow(cmd *cobra.Command, args []string) error {
+	if configShowFormat != "yaml" && configShowFormat != "json" {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("invalid format '%s' (valid formats: yaml, json)", configShowFormat))
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if configShowFormat == "json" {
+		data, err := json.MarshalIndent(cfg, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode configuration: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to encode configuration: %w", err)
+	}
+	fmt.Print(string(data))
+	return nil
+}
+
+// runConfigPath prints the path of the selected configuration file
+func runConfigPath(cmd *cobra.Command, args []string) error {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return err
+	}
+
+	path, err := configManager.LayerPath(configLayer())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(path)
+	return nil
+}
+
+// runConfigGet prints the effective value of one configuration key
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	value, err := config.LookupValue(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode value: %w", err)
+		}
+		fmt.Print(string(data))
+	default:
+		fmt.Println(value)
+	}
+
+	return nil
+}
+
+// runConfigSet writes one configuration value to the selected file
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	key, raw := args[0], args[1]
+
+	value, err := config.ParseKeyValue(key, raw)
+	if err != nil {
+		return err
+	}
+
+	configManager, err := newConfigManager()
+	if err != nil {
+		return err
+	}
+
+	layer := configLayer()
+	path, err := configManager.LayerPath(layer)
+	if err != nil {
+		return err
+	}
+
+	data, err := readConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	updated, err := config.SetYAMLValue(data, key, value)
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", path, err)
+	}
+
+	if err := checkConfigContent(configManager, layer, updated); err != nil {
+		return err
+	}
+
+	if err := configManager.WriteLayer(layer, updated); err != nil {
+		return err
+	}
+
+	fmt.Printf("Set %s in %s\n", key, path)
+	return nil
+}
+
+// runConfigEdit opens the selected configuration file in an editor and saves it once valid
+func runConfigEdit(cmd *cobra.Command, args []string) error {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return err
+	}
+
+	layer := configLayer()
+	path, err := configManager.LayerPath(layer)
+	if err != nil {
+		return err
+	}
+
+	original, err := readConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	// Edit a temporary copy so an invalid file never replaces the real one
+	tempFile, err := os.CreateTemp("", "jiraflow-*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tempFile.Name())
+
+	if _, err := tempFile.Write(original); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	for {
+		if err := runEditor(tempFile.Name()); err != nil {
+			return err
+		}
+
+		edited, err := os.ReadFile(tempFile.Name())
+		if err != nil {
+			return fmt.Errorf("failed to read edited file: %w", err)
+		}
+
+		if bytes.Equal(edited, original) {
+			fmt.Println("No changes made.")
+			return nil
+		}
+
+		checkErr := checkConfigContent(configManager, layer, edited)
+		if checkErr == nil {
+			if err := configManager.WriteLayer(layer, edited); err != nil {
+				return err
+			}
+			fmt.Printf("Saved %s\n", path)
+			return nil
+		}
+
+		fmt.Fprintf(os.Stderr, "%v\n", checkErr)
+		fmt.Print("Re-open the editor? [Y/n] ")
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		answer = strings.ToLower(strings.TrimSpace(answer))
+		if answer == "n" || answer == "no" {
+			return fmt.Errorf("changes to %s were discarded", path)
+		}
+	}
+}
+
+// runConfigValidate reports all errors in the effective configuration
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return err
+	}
+
+	_, validationErrors, err := configManager.LoadStrict()
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range configManager.GetWarnings() {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	if len(validationErrors) == 0 {
+		fmt.Println("Configuration is valid.")
+		return nil
+	}
+
+	for _, validationError := range validationErrors {
+		fmt.Fprintf(os.Stderr, "  - %s\n", validationError.Error())
+	}
+	return errors.NewConfigError("", nil, fmt.Sprintf("%d configuration error(s) found", len(validationErrors)), true)
+}
+
+// runConfigInit writes the default user configuration file
+func runConfigInit(cmd *cobra.Command, args []string) error {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return err
+	}
+
+	path := configManager.GetConfigPath()
+	if _, err := os.Stat(path); err == nil && !configInitForce {
+		return fmt.Errorf("configuration file %s already exists (use --force to overwrite it)", path)
+	}
+
+	if err := configManager.CreateDefault(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Created default configuration at %s\n", path)
+	return nil
+}
+
+// runConfigMigrate upgrades the named configuration files, or the selected layer's file
+func runConfigMigrate(cmd *cobra.Command, args []string) error {
+	paths := args
+	if len(paths) == 0 {
+		configManager, err := newConfigManager()
+		if err != nil {
+			return err
+		}
+		path, err := configManager.LayerPath(configLayer())
+		if err != nil {
+			return err
+		}
+		paths = []string{path}
+	}
+
+	for _, path := range paths {
+		backupPath, err := config.MigrateFile(path)
+		if err != nil {
+			return err
+		}
+		if backupPath == "" {
+			fmt.Printf("%s is up to date\n", path)
+			continue
+		}
+		fmt.Printf("Upgraded %s to configuration version %d (backup: %s)\n", path, config.CurrentVersion, backupPath)
+	}
+	return nil
+}
+
+// runConfigSchema prints the generated JSON Schema
+func runConfigSchema(cmd *cobra.Command, args []string) error {
+	schema, err := config.GenerateSchema()
+	if err != nil {
+		return fmt.Errorf("failed to generate schema: %w", err)
+	}
+
+	fmt.Print(string(schema))
+	return nil
+}
+
+// runConfigSources prints the configuration layers and the origin of every value
+func runConfigSources(cmd *cobra.Command, args []string) error {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return errors.WrapError(err, errors.ErrorTypeConfig, true)
+	}
+	if _, err := configManager.Load(); err != nil {
+		return errors.WrapError(err, errors.ErrorTypeConfig, true)
+	}
+
+	fmt.Println("Configuration files (lowest to highest precedence):")
+	for _, layer := range configManager.GetLayers() {
+		fmt.Printf("  %-7s %s\n", layer.Name, layer.Path)
+	}
+
+	if profile, reason := configManager.GetActiveProfile(); profile != "" {
+		fmt.Printf("\nActive profile: %s (%s)\n", profile, reason)
+	}
+
+	if locked := configManager.GetLockedKeys(); len(locked) > 0 {
+		fmt.Println()
+		fmt.Println("Locked keys:")
+		for _, lock := range locked {
+			fmt.Printf("  %-32s %s\n", lock.Key, lock.Source)
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("Effective values:")
+	for _, source := range configManager.GetValueSources() {
+		fmt.Printf("  %-32s %s\n", source.Key, source.Source)
+	}
+
+	return nil
+}
+
+// readConfigFile reads a configuration file, treating a missing file as empty
+func readConfigFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// checkConfigContent validates data as the content of a layer's file on its own
+// Other layers, environment variables and --set overrides do not hide or cause errors
+func checkConfigContent(configManager *config.FileConfigManager, layer string, data []byte) error {
+	path, err := configManager.LayerPath(layer)
+	if err != nil {
+		return err
+	}
+
+	if err := config.CheckFileContent(path, data); err != nil {
+		return fmt.Errorf("%s is invalid, nothing was saved: %w", path, err)
+	}
+	return nil
+}
+
+// runEditor opens a file in $VISUAL or $EDITOR, falling back to vi
+func runEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// The editor variable may include arguments, e.g. "code --wait"
+	parts := strings.Fields(editor)
+	editorCmd := exec.Command(parts[0], append(parts[1:], path)...)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+
+	if err := editorCmd.Run(); err != nil {
+		return fmt.Errorf("editor '%s' failed: %w", editor, err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+	"jiraflow/internal/history"
+)
+
+var (
+	// History command flags
+	historyTicket string
+	historyType   string
+	historyRepo   string
+	historyHere   bool
+	historySince  string
+	historyLimit  int
+	historyFormat string
+)
+
+// historyCmd lists the branches created by JiraFlow
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List the branches created by JiraFlow",
+	Long: `List the branches created by JiraFlow, newest first.
+
+Every branch created in interactive mode, non-interactive mode or by the batch
+command is recorded with the time, repository, branch type, base branch,
+ticket and title. The history is kept in ~/.config/jiraflow/history.jsonl and
+holds the last 1000 branches. The interactive ticket step offers the tickets
+of the most recent branches for reuse.
+
+Examples:
+  # Show the last 20 branches
+  jiraflow history
+
+  # Branches of a ticket, in the current repository only
+  jiraflow history --ticket PROJ-123 --here
+
+  # Bugfix branches of the last week as JSON
+  jiraflow history --type bugfix --since 7d --format json`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runHistory,
+}
+
+func init() {
+	historyCmd.Flags().StringVar(&historyTicket, "ticket", "", "Only branches of this ticket")
+	historyCmd.Flags().StringVarP(&historyType, "type", "t", "", "Only branches of this type")
+	historyCmd.Flags().StringVar(&historyRepo, "repo", "", "Only repositories whose path contains this text")
+	historyCmd.Flags().BoolVar(&historyHere, "here", false, "Only the current repository")
+	historyCmd.Flags().StringVar(&historySince, "since", "", "Only branches created since a duration ago (e.g. 36h, 7d) or a date (YYYY-MM-DD)")
+	historyCmd.Flags().IntVarP(&historyLimit, "limit", "n", 20, "Maximum number of branches shown, 0 for all")
+	historyCmd.Flags().StringVar(&historyFormat, "format", "text", "Output format (text, json)")
+	historyCmd.MarkFlagsMutuallyExclusive("repo", "here")
+	_ = historyCmd.RegisterFlagCompletionFunc("ticket", completeTickets)
+	_ = historyCmd.RegisterFlagCompletionFunc("type", completeBranchTypes)
+	_ = historyCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions([]string{"text", "json"}, cobra.ShellCompDirectiveNoFileComp))
+	rootCmd.AddCommand(historyCmd)
+}
+
+// runHistory is the entry point for the history command
+func runHistory(cmd *cobra.Command, args []string) error {
+	if historyFormat != "text" && historyFormat != "json" {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("invalid format '%s' (valid formats: text, json)", historyFormat))
+	}
+
+	filter := history.Filter{
+		Repo:   historyRepo,
+		Ticket: strings.TrimSpace(historyTicket),
+		Type:   historyType,
+		Limit:  historyLimit,
+	}
+
+	if historyHere {
+		gitRepo := git.NewLocalGitRepository()
+		if !gitRepo.IsGitRepository() {
+			return errNotGitRepository
+		}
+		root, err := gitRepo.GetTopLevel()
+		if err != nil {
+			return fmt.Errorf("failed to get repository root: %w", err)
+		}
+		filter.Repo = root
+	}
+
+	if historySince != "" {
+		since, err := parseSince(historySince, time.Now())
+		if err != nil {
+			return errors.NewUsageError(cmd.CommandPath(), err.Error())
+		}
+		filter.Since = since
+	}
+
+	store := newHistoryStore()
+	entries, err := store.Load()
+	if err != nil {
+		return fmt.Errorf("failed to read history %s: %w", store.Path(), err)
+	}
+	entries = filter.Apply(entries)
+
+	if historyFormat == "json" {
+		if entries == nil {
+			entries = []history.Entry{}
+		}
+		return writeJSON(os.Stdout, entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No branches in the history")
+		return nil
+	}
+	printHistoryEntries(entries)
+	return nil
+}
+
+// parseSince parses --since as a duration before now, with d for days, or as a date
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if date, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return date, nil
+	}
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		var count int
+		if _, err := fmt.Sscanf(days, "%d", &count); err == nil && count >= 0 && fmt.Sprint(count) == days {
+			return now.AddDate(0, 0, -count), nil
+		}
+	} else if duration, err := time.ParseDuration(value); err == nil && duration >= 0 {
+		return now.Add(-duration), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --since '%s'\n  Use a duration such as 36h or 7d, or a date such as 2024-05-01", value)
+}
+
+// printHistoryEntries prints one line per entry: time, ticket, branch, base and repository
+func printHistoryEntries(entries []history.Entry) {
+	ticketWidth, branchWidth := 0, 0
+	for _, entry := range entries {
+		ticketWidth = max(ticketWidth, len(entry.Ticket))
+		branchWidth = max(branchWidth, len(entry.Branch))
+	}
+
+	for _, entry := range entries {
+		fmt.Printf("%s  %-*s  %-*s  from %s  %s\n",
+			entry.Time.Local().Format("2006-01-02 15:04"),
+			ticketWidth, entry.Ticket,
+			branchWidth, entry.Branch,
+			entry.Base, entry.Repo)
+	}
+}
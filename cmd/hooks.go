@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/branch"
+	"jiraflow/internal/git"
+	"jiraflow/internal/hooks"
+)
+
+var (
+	// Hooks command flags
+	hooksOnly []string
+)
+
+// hooksCmd groups the Git hook commands
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "Install Git hooks that enforce JiraFlow conventions",
+	Long: `Install or remove Git hooks that enforce JiraFlow conventions:
+
+  pre-push            reject pushing branches that fail 'jiraflow lint'
+  prepare-commit-msg  prefix commit messages with the ticket key of the branch
+  commit-msg          require a ticket key in commit messages
+
+Hooks are written to the repository's hooks directory, or to core.hooksPath
+if it is set. Existing hooks are not overwritten: they are kept next to the
+JiraFlow hook with the suffix .jiraflow-chained and run first. Uninstalling
+restores them.
+
+The prefix added by prepare-commit-msg is configured with hooks.commit_prefix.
+Merge, revert and fixup commits need no ticket key. Use git's --no-verify to
+skip the hooks once.`,
+	Args: cobra.NoArgs,
+}
+
+// hooksInstallCmd installs the hooks
+var hooksInstallCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the JiraFlow Git hooks",
+	Long: `Install the JiraFlow Git hooks into the current repository.
+
+Examples:
+  # Install all hooks
+  jiraflow hooks install
+
+  # Only check branch names before pushing
+  jiraflow hooks install --hook pre-push`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runHooksInstall,
+}
+
+// hooksUninstallCmd removes the hooks
+var hooksUninstallCmd = &cobra.Command{
+	Use:          "uninstall",
+	Short:        "Remove the JiraFlow Git hooks and restore chained hooks",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runHooksUninstall,
+}
+
+// hooksRunCmd is called by the installed hook scripts
+var hooksRunCmd = &cobra.Command{
+	Use:          "run <hook> [args...]",
+	Short:        "Run a JiraFlow Git hook (called by the installed hooks)",
+	Args:         cobra.MinimumNArgs(1),
+	Hidden:       true,
+	SilenceUsage: true,
+	RunE:         runHooksRun,
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{hooksInstallCmd, hooksUninstallCmd} {
+		cmd.Flags().StringSliceVar(&hooksOnly, "hook", nil, "Only this hook (pre-push, prepare-commit-msg, commit-msg), repeatable")
+	}
+	hooksCmd.AddCommand(hooksInstallCmd, hooksUninstallCmd, hooksRunCmd)
+	rootCmd.AddCommand(hooksCmd)
+}
+
+// selectedHooks returns the hooks chosen with --hook, or all hooks
+func selectedHooks() ([]string, error) {
+	if len(hooksOnly) == 0 {
+		return hooks.Names, nil
+	}
+	for _, hook := range hooksOnly {
+		if !hooks.IsHook(hook) {
+			return nil, fmt.Errorf("unknown hook '%s' (valid hooks: %s)", hook, strings.Join(hooks.Names, ", "))
+		}
+	}
+	return hooksOnly, nil
+}
+
+// hooksDir returns the hooks directory of the current repository
+func hooksDir() (string, error) {
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return "", errNotGitRepository
+	}
+	return gitRepo.GetHooksPath()
+}
+
+// runHooksInstall writes the hook scripts
+func runHooksInstall(cmd *cobra.Command, args []string) error {
+	names, err := selectedHooks()
+	if err != nil {
+		return err
+	}
+	dir, err := hooksDir()
+	if err != nil {
+		return err
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		executable = "jiraflow"
+	} else if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
+	}
+
+	results, err := hooks.Install(dir, executable, names)
+	for _, result := range results {
+		if result.Chained {
+			fmt.Printf("✓ %s %s (runs existing %s%s first)\n", result.Action, result.Path, result.Hook, hooks.ChainedSuffix)
+		} else {
+			fmt.Printf("✓ %s %s\n", result.Action, result.Path)
+		}
+	}
+	return err
+}
+
+// runHooksUninstall removes the hook scripts
+func runHooksUninstall(cmd *cobra.Command, args []string) error {
+	names, err := selectedHooks()
+	if err != nil {
+		return err
+	}
+	dir, err := hooksDir()
+	if err != nil {
+		return err
+	}
+
+	results, err := hooks.Uninstall(dir, names)
+	for _, result := range results {
+		switch {
+		case result.Chained:
+			fmt.Printf("✓ %s %s (restored the previous hook)\n", result.Action, result.Path)
+		case result.Action == "removed":
+			fmt.Printf("✓ %s %s\n", result.Action, result.Path)
+		default:
+			fmt.Printf("- %s: not installed by JiraFlow\n", result.Path)
+		}
+	}
+	return err
+}
+
+// runHooksRun performs the check of a hook with the arguments Git passed to it
+func runHooksRun(cmd *cobra.Command, args []string) error {
+	hook, hookArgs := args[0], args[1:]
+	if !hooks.IsHook(hook) {
+		return fmt.Errorf("unknown hook '%s' (valid hooks: %s)", hook, strings.Join(hooks.Names, ", "))
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	switch hook {
+	case hooks.PrePush:
+		names, err := hooks.PushedBranches(os.Stdin)
+		if err != nil {
+			return err
+		}
+		linter := branch.NewLinter(newLintConfig(cfg))
+		failed := 0
+		for _, name := range names {
+			result := linter.Lint(name)
+			if result.Valid {
+				continue
+			}
+			failed++
+			fmt.Fprintf(os.Stderr, "✗ %s\n", name)
+			for _, violation := range result.Violations {
+				fmt.Fprintf(os.Stderr, "    %s: %s\n", violation.Rule, violation.Message)
+			}
+			if result.Suggestion != "" {
+				fmt.Fprintf(os.Stderr, "    suggestion: git branch -m %s %s\n", name, result.Suggestion)
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("push rejected: %d branch name(s) violate the naming policy", failed)
+		}
+		return nil
+
+	case hooks.PrepareCommitMsg:
+		if len(hookArgs) == 0 {
+			return fmt.Errorf("%s needs the commit message file", hook)
+		}
+		// Messages of merges, squashes and reused commits are left alone
+		if len(hookArgs) > 1 && (hookArgs[1] == "merge" || hookArgs[1] == "squash" || hookArgs[1] == "commit") {
+			return nil
+		}
+		ticket := currentBranchTicket(cfg.BranchTypes, cfg.Sanitization.Separator)
+		if ticket == "" {
+			return nil
+		}
+		message, err := os.ReadFile(hookArgs[0])
+		if err != nil {
+			return fmt.Errorf("failed to read commit message: %w", err)
+		}
+		prefixed := hooks.PrefixMessage(string(message), cfg.Hooks.CommitPrefix, ticket)
+		if prefixed == string(message) {
+			return nil
+		}
+		return os.WriteFile(hookArgs[0], []byte(prefixed), 0644)
+
+	default: // hooks.CommitMsg
+		if len(hookArgs) == 0 {
+			return fmt.Errorf("%s needs the commit message file", hook)
+		}
+		message, err := os.ReadFile(hookArgs[0])
+		if err != nil {
+			return fmt.Errorf("failed to read commit message: %w", err)
+		}
+		if hooks.IsExempt(string(message)) || hooks.HasTicketKey(string(message)) {
+			return nil
+		}
+		return fmt.Errorf("commit message must contain a Jira ticket key like PROJ-123 (use --no-verify to skip this check)")
+	}
+}
+
+// currentBranchTicket returns the ticket key of the current branch, or "" if it has none
+func currentBranchTicket(branchTypes map[string]string, separator string) string {
+	currentBranch, err := git.NewLocalGitRepository().GetCurrentBranch()
+	if err != nil {
+		return ""
+	}
+	parser := branch.NewBranchParser(branch.ParserConfigFromAppConfig(branchTypes, separator))
+	parsed, err := parser.Parse(currentBranch)
+	if err != nil {
+		return ""
+	}
+	return parsed.TicketID
+}
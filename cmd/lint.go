@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/branch"
+	"jiraflow/internal/config"
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+)
+
+var (
+	// Lint command flags
+	lintAll    bool
+	lintFormat string
+)
+
+// lintCmd checks branch names against the configured naming policy
+var lintCmd = &cobra.Command{
+	Use:   "lint [branch...]",
+	Short: "Check branch names against the naming policy",
+	Long: `Check branch names against the configured branch types, the
+type/TICKET-title template, the ticket key format, sanitization, the maximum
+branch length and Git's ref name rules.
+
+Without arguments the current branch is checked. Use - to read branch names
+from standard input, one per line. Branches matching lint.ignore are skipped.
+Every violation is reported with a suggested conforming name. Exits with
+status 1 if any branch name violates the policy.
+
+Examples:
+  # Check the current branch, e.g. in a pre-push hook
+  jiraflow lint
+
+  # Check every local branch
+  jiraflow lint --all
+
+  # Check a list of names and write a JUnit report for CI
+  git for-each-ref --format='%(refname:lstrip=3)' refs/remotes/origin | jiraflow lint - --format junit`,
+	SilenceUsage: true,
+	RunE:         runLint,
+}
+
+func init() {
+	lintCmd.Flags().BoolVarP(&lintAll, "all", "a", false, "Check every local branch")
+	lintCmd.Flags().StringVar(&lintFormat, "format", "text", "Output format (text, json, junit)")
+	rootCmd.AddCommand(lintCmd)
+}
+
+// runLint is the entry point for the lint command
+func runLint(cmd *cobra.Command, args []string) error {
+	if lintFormat != "text" && lintFormat != "json" && lintFormat != "junit" {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("invalid format '%s' (valid formats: text, json, junit)", lintFormat))
+	}
+	if lintAll && len(args) > 0 {
+		return errors.NewUsageError(cmd.CommandPath(), "--all cannot be combined with branch names")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	names, err := lintBranchNames(args, os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	linter := branch.NewLinter(newLintConfig(cfg))
+	results := make([]branch.LintResult, len(names))
+	failed := 0
+	for i, name := range names {
+		results[i] = linter.Lint(name)
+		if !results[i].Valid {
+			failed++
+		}
+	}
+
+	switch lintFormat {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(results); err != nil {
+			return err
+		}
+	case "junit":
+		if err := writeLintJUnit(os.Stdout, results, failed); err != nil {
+			return err
+		}
+	default:
+		printLintResults(results)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d branch name(s) violate the naming policy", failed, len(results))
+	}
+	return nil
+}
+
+// lintBranchNames returns the branch names to check: the arguments, the names read
+// from stdin for "-", every local branch with --all, or else the current branch
+func lintBranchNames(args []string, stdin io.Reader) ([]string, error) {
+	if len(args) == 1 && args[0] == "-" {
+		var names []string
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			// Accept the output of git branch, which marks the current branch with "* "
+			name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "* "))
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read branch names: %w", err)
+		}
+		return names, nil
+	}
+	if len(args) > 0 {
+		return args, nil
+	}
+
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return nil, errNotGitRepository
+	}
+
+	if lintAll {
+		names, err := gitRepo.GetLocalBranches()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list local branches: %w", err)
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+
+	current, err := gitRepo.GetCurrentBranch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
+	}
+	return []string{current}, nil
+}
+
+// newLintConfig creates the naming policy from the configuration
+func newLintConfig(cfg *config.Config) branch.LintConfig {
+	return branch.LintConfig{
+		Parser:      branch.ParserConfigFromAppConfig(cfg.BranchTypes, cfg.Sanitization.Separator),
+		DefaultType: cfg.DefaultBranchType,
+		Generator:   newGeneratorConfig(cfg),
+		Ignore:      cfg.Lint.Ignore,
+	}
+}
+
+// printLintResults prints the lint results in human readable form
+func printLintResults(results []branch.LintResult) {
+	for _, result := range results {
+		switch {
+		case result.Ignored:
+			fmt.Printf("- %s (ignored)\n", result.Branch)
+		case result.Valid:
+			fmt.Printf("✓ %s\n", result.Branch)
+		default:
+			fmt.Printf("✗ %s\n", result.Branch)
+			for _, violation := range result.Violations {
+				fmt.Printf("    %s: %s (position %d)\n", violation.Rule, violation.Message, violation.Position)
+			}
+			if result.Suggestion != "" {
+				fmt.Printf("    suggestion: %s\n", result.Suggestion)
+			}
+		}
+	}
+}
+
+// JUnit XML report elements, as understood by common CI systems
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *struct{}     `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeLintJUnit writes the lint results as a JUnit XML report with one test case per branch
+func writeLintJUnit(w io.Writer, results []branch.LintResult, failed int) error {
+	suite := junitTestSuite{Name: "jiraflow lint", Tests: len(results), Failures: failed}
+	for _, result := range results {
+		testCase := junitTestCase{Name: result.Branch, ClassName: "jiraflow.lint"}
+		switch {
+		case result.Ignored:
+			testCase.Skipped = &struct{}{}
+			suite.Skipped++
+		case !result.Valid:
+			var text strings.Builder
+			for _, violation := range result.Violations {
+				fmt.Fprintf(&text, "%s: %s (position %d)\n", violation.Rule, violation.Message, violation.Position)
+			}
+			if result.Suggestion != "" {
+				fmt.Fprintf(&text, "suggestion: %s\n", result.Suggestion)
+			}
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d naming policy violation(s)", len(result.Violations)),
+				Text:    text.String(),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -1,28 +1,42 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"jiraflow/internal/branch"
 	"jiraflow/internal/config"
+	"jiraflow/internal/errors"
 	"jiraflow/internal/git"
+	"jiraflow/internal/history"
 	"jiraflow/internal/jira"
 	"jiraflow/internal/tui"
+	"jiraflow/internal/undo"
 )
 
 var (
 	// Global flags
 	interactive bool
 	dryRun      bool
+	configFile  string
+	configSets  []string
+	profileName string
 	
 	// Non-interactive mode flags
 	branchType   string
 	baseBranch   string
 	ticketNumber string
 	ticketTitle  string
+	outputFormat string
+	quiet        bool
+
+	// ticketFromStdin is set when the ticket is read from standard input with -
+	ticketFromStdin bool
 	
 	// Version information (placeholders for build-time injection)
 	appVersion   = "dev"      //nolint:unused // Set by build process
@@ -31,7 +45,7 @@ var (
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
-	Use:   "jiraflow [flags]",
+	Use:   "jiraflow [-] [flags]",
 	Short: "Interactive Git branch creation tool for Jira workflows",
 	Long: `JiraFlow is a CLI tool that helps developers create Git branches 
 following Jira ticket naming conventions with an interactive TUI interface.
@@ -44,7 +58,10 @@ Interactive Mode (default):
 
 Non-Interactive Mode:
   Provide all required information via command-line flags to create branches
-  without user interaction, perfect for automation and scripting.
+  without user interaction, perfect for automation and scripting. With - a
+  "KEY title" line is read from standard input instead of --ticket and --title.
+  The interactive mode is only started when standard input and output are
+  terminals.
 
 Examples:
   # Launch interactive mode (default)
@@ -57,13 +74,37 @@ Examples:
   jiraflow --dry-run --type hotfix --base develop --ticket PROJ-456 --title "Fix login bug"
 
   # Non-interactive with minimal flags (title fetched from Jira if available)
-  jiraflow --type feature --ticket PROJ-789`,
+  jiraflow --type feature --ticket PROJ-789
+
+  # Machine-readable result for scripts
+  jiraflow --type feature --ticket PROJ-789 --output json
+
+  # Pick a ticket with fzf and create its branch
+  jira issue list --plain --columns key,summary | fzf | jiraflow - --type bugfix`,
+	Args: rootArgs,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		commandStarted = true
+	},
 	RunE: runJiraFlow,
+	// Errors are printed by the error handler in main, which also picks the exit code;
+	// usage is only shown on request, not after every failure
+	SilenceErrors: true,
+	SilenceUsage:  true,
 }
 
+// commandStarted is set once the flags and arguments were accepted and a command runs
+var commandStarted bool
+
 // Execute adds all child commands to the root command and sets flags appropriately.
+// Errors from parsing flags and arguments are returned as usage errors
 func Execute() error {
-	return rootCmd.Execute()
+	cmd, err := rootCmd.ExecuteC()
+	if err != nil && !commandStarted {
+		if _, ok := errors.AsJiraFlowError(err); !ok {
+			return errors.NewUsageError(cmd.CommandPath(), err.Error())
+		}
+	}
+	return err
 }
 
 // SetVersionInfo sets the version information for the application
@@ -77,18 +118,37 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().BoolVarP(&interactive, "interactive", "i", true, "Run in interactive mode (default)")
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Preview branch name without creating the branch")
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Use this configuration file instead of ~/.config/jiraflow/jiraflow.yaml (env JIRAFLOW_CONFIG)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "Use this configuration profile instead of matching one automatically (env JIRAFLOW_PROFILE)")
+	rootCmd.PersistentFlags().StringArrayVar(&configSets, "set", nil, "Override a configuration key, e.g. --set max_branch_length=50 (repeatable)")
 	
 	// Non-interactive mode flags
-	rootCmd.Flags().StringVarP(&branchType, "type", "t", "", "Branch type (feature, hotfix, refactor, support)")
+	rootCmd.Flags().StringVarP(&branchType, "type", "t", "", typeFlagUsage(config.GetDefaultConfig()))
 	rootCmd.Flags().StringVarP(&baseBranch, "base", "b", "", "Base branch to create new branch from (defaults to current branch)")
-	rootCmd.Flags().StringVar(&ticketNumber, "ticket", "", "Jira ticket number (e.g., PROJ-123)")
+	rootCmd.Flags().StringVar(&ticketNumber, "ticket", "", "Jira ticket number (e.g., PROJ-123), or - to read \"KEY title\" from stdin")
 	rootCmd.Flags().StringVar(&ticketTitle, "title", "", "Ticket title (optional, will fetch from Jira if not provided)")
+	rootCmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Output format of non-interactive mode (text, json)")
+	rootCmd.Flags().BoolVarP(&quiet, "quiet", "q", false, "Only print the generated branch name in non-interactive mode")
+	_ = rootCmd.RegisterFlagCompletionFunc("type", completeBranchTypes)
+	_ = rootCmd.RegisterFlagCompletionFunc("base", completeBaseBranches)
+	_ = rootCmd.RegisterFlagCompletionFunc("ticket", completeTickets)
+	_ = rootCmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions([]string{"text", "json"}, cobra.ShellCompDirectiveNoFileComp))
 	
+	// List the configured branch types in the --type help
+	defaultHelp := rootCmd.HelpFunc()
+	rootCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		if cmd == rootCmd {
+			updateTypeFlagUsage()
+		}
+		defaultHelp(cmd, args)
+	})
+
 	// Mark flags as mutually exclusive with interactive mode
 	rootCmd.MarkFlagsMutuallyExclusive("interactive", "type")
 	rootCmd.MarkFlagsMutuallyExclusive("interactive", "base")
 	rootCmd.MarkFlagsMutuallyExclusive("interactive", "ticket")
 	rootCmd.MarkFlagsMutuallyExclusive("interactive", "title")
+	rootCmd.MarkFlagsMutuallyExclusive("output", "quiet")
 	
 	// Add help command
 	rootCmd.AddCommand(&cobra.Command{
@@ -111,48 +171,178 @@ Exit Codes:
   2 - Configuration error
   3 - Git repository error
   4 - Jira integration error
+  5 - Terminal interface error
+  6 - Invalid flags or arguments
 
 Configuration:
   JiraFlow automatically creates a configuration file at:
   ~/.config/jiraflow/jiraflow.yaml
 
   You can customize branch types, naming conventions, and other settings
-  by editing this file.`,
+  by editing this file.
+
+  Configuration files are merged in this order, later files overriding
+  earlier ones:
+    1. /etc/jiraflow/jiraflow.yaml   (system-wide, optional)
+    2. ~/.config/jiraflow/jiraflow.yaml (user)
+    3. <repository root>/.jiraflow.yaml (repository-local, optional)
+
+  Any key can be overridden for a single run, taking precedence over all
+  files, by an environment variable or the --set flag:
+    JIRAFLOW_MAX_BRANCH_LENGTH=50 jiraflow
+    jiraflow --set sanitization.separator=_ --set branch_types.bugfix=bugfix/
+  Command line overrides win over environment variables. Lists are comma
+  separated and maps are written as key=value pairs.
+
+  Use --config <path> (or JIRAFLOW_CONFIG) to read a different user
+  configuration file.
+
+  Profiles bundle settings for different projects. A profile is applied
+  when selected with --profile <name> (or JIRAFLOW_PROFILE), or
+  automatically when its match patterns fit the repository's remote URL
+  or path.
+
+  Run 'jiraflow config sources' to see which file each value came from.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			_ = cmd.Help()
 		},
 	})
 }
 
-// runJiraFlow is the main entry point for the CLI command
-func runJiraFlow(cmd *cobra.Command, args []string) error {
-	// Load configuration
+// newConfigManager creates the configuration manager for the current directory
+// Inside a Git repository the repository-local .jiraflow.yaml is layered over the user config
+// The --config, --profile and --set flags (and JIRAFLOW_CONFIG, JIRAFLOW_PROFILE) are applied here
+func newConfigManager() (*config.FileConfigManager, error) {
 	configManager := config.NewFileConfigManager()
+	gitRepo := git.NewLocalGitRepository()
+	if root, err := gitRepo.GetTopLevel(); err == nil {
+		configManager.SetRepoRoot(root)
+		if urls, err := gitRepo.GetRemoteURLs(); err == nil {
+			configManager.SetRemoteURLs(urls)
+		}
+		configManager.SetGitFileReader(gitRepo.ReadFileAtRef)
+	}
+
+	path := configFile
+	if path == "" {
+		path = os.Getenv(config.EnvPrefix + "CONFIG")
+	}
+	if path != "" {
+		configManager.SetConfigPath(path)
+	}
+
+	profile := profileName
+	if profile == "" {
+		profile = os.Getenv(config.EnvPrefix + "PROFILE")
+	}
+	configManager.SetProfile(profile)
+
+	if err := configManager.SetOverrides(configSets); err != nil {
+		return nil, err
+	}
+
+	return configManager, nil
+}
+
+// errNotGitRepository is returned by commands that need a Git repository
+var errNotGitRepository = errors.NewGitError("rev-parse", "current directory is not a Git repository", false)
+
+// newJiraClient creates the Jira CLI client configured by the jira section
+// Fetched tickets are cached for shell completion
+func newJiraClient(cfg *config.Config) jira.JiraClient {
+	client := jira.NewConfiguredCLIClient(config.ExpandHome(cfg.Jira.CLIPath), config.ExpandHome(cfg.Jira.ConfigFile))
+	return jira.NewCachingClient(client, jira.NewTicketCache(config.TicketCachePath(config.StateDir())))
+}
+
+// newHistoryStore creates the store of the branches created by JiraFlow
+func newHistoryStore() *history.Store {
+	return history.NewStore(config.HistoryPath(config.StateDir()))
+}
+
+// recordHistory adds a created branch to the history with the repository it was created in
+// Failing to write the history does not fail the command
+func recordHistory(gitRepo git.GitRepository, entry history.Entry) {
+	if entry.Repo == "" {
+		entry.Repo, _ = gitRepo.GetTopLevel()
+	}
+	_ = newHistoryStore().Add(entry)
+}
+
+// loadConfig loads the application configuration
+// Failures are reported as configuration errors so that they exit with status 2
+func loadConfig() (*config.Config, error) {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return nil, errors.WrapError(err, errors.ErrorTypeConfig, true)
+	}
 	cfg, err := configManager.Load()
 	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
+		return nil, errors.WrapError(err, errors.ErrorTypeConfig, true)
 	}
+	return cfg, nil
+}
 
-	// Initialize Git repository
-	gitRepo := git.NewLocalGitRepository()
-	if !gitRepo.IsGitRepository() {
-		return fmt.Errorf("current directory is not a Git repository")
+// rootArgs accepts only "-", which reads the ticket from standard input
+func rootArgs(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 || (len(args) == 1 && args[0] == "-") {
+		return nil
+	}
+	if args[0] != "-" {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("unknown command %q for %q", args[0], cmd.CommandPath()))
+	}
+	return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("unexpected argument %q (only - is accepted)", args[1]))
+}
+
+// runJiraFlow is the main entry point for the CLI command
+func runJiraFlow(cmd *cobra.Command, args []string) error {
+	if outputFormat != "text" && outputFormat != "json" {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("invalid output format '%s' (valid formats: text, json)", outputFormat))
+	}
+
+	ticketFromStdin = len(args) == 1 || ticketNumber == "-"
+	if len(args) == 1 && ticketNumber != "" {
+		return errors.NewUsageError(cmd.CommandPath(), "- cannot be combined with --ticket")
 	}
 
 	// Determine mode based on flags
-	isNonInteractive := branchType != "" || baseBranch != "" || ticketNumber != ""
+	isNonInteractive := branchType != "" || baseBranch != "" || ticketNumber != "" || ticketFromStdin
 	
 	if isNonInteractive {
 		// Force interactive to false if any non-interactive flags are provided
 		interactive = false
-		return runNonInteractiveMode(cfg, gitRepo)
+		result := &branchResult{DryRun: dryRun}
+		err := runNonInteractiveMode(result)
+		if outputFormat == "json" {
+			result.Error = errors.NewErrorReport(err)
+			if writeErr := writeJSON(os.Stdout, result); writeErr != nil {
+				return writeErr
+			}
+			return errors.MarkReported(err)
+		}
+		return err
+	}
+
+	if outputFormat != "text" || quiet {
+		return errors.NewUsageError(cmd.CommandPath(), "--output and --quiet require non-interactive mode (--type and --ticket)")
+	}
+
+	// The TUI needs a terminal; refuse instead of waiting for input that never comes
+	if !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+		return errors.NewTUIError("terminal", "interactive mode needs a terminal, but standard input or output is redirected", false)
+	}
+
+	// Load configuration
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
 	}
 
-	if interactive {
-		return runInteractiveMode(cfg, gitRepo)
+	// Initialize Git repository
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return errNotGitRepository
 	}
 
-	// This should not be reached, but provide fallback
 	return runInteractiveMode(cfg, gitRepo)
 }
 
@@ -164,35 +354,140 @@ func runInteractiveMode(cfg *config.Config, gitRepo git.GitRepository) error {
 		fmt.Println()
 	}
 	
-	// Display keyboard shortcuts help before launching TUI
-	fmt.Println("🚀 JiraFlow Interactive Mode")
-	fmt.Println()
-	fmt.Println("Keyboard Shortcuts:")
-	fmt.Println("  ↑/↓ or j/k    Navigate lists")
-	fmt.Println("  /             Search (in branch selection)")
-	fmt.Println("  Enter         Select/Confirm")
-	fmt.Println("  Esc           Go back")
-	fmt.Println("  q or Ctrl+C   Quit")
-	fmt.Println()
-	fmt.Println("Press any key to continue...")
-	
-	// Wait for user input before launching TUI
-	var input string
-	_, _ = fmt.Scanln(&input)
+	// Introduce the workflow and shortcuts in the TUI the first time it is used
+	stateDir := config.StateDir()
+	generatorConfig := newGeneratorConfig(cfg)
+	opts := tui.Options{
+		Onboarding: config.IsFirstRun(stateDir),
+		Generator:  &generatorConfig,
+		History:    newHistoryStore(),
+	}
 	
 	// Launch TUI and handle any errors
-	if err := tui.RunTUI(cfg, gitRepo); err != nil {
+	err := tui.RunTUIWithOptions(cfg, gitRepo, opts)
+	if opts.Onboarding {
+		// Best effort; without a writable state directory the welcome screen is shown again
+		_ = config.MarkOnboarded(stateDir)
+	}
+	if err != nil {
 		return fmt.Errorf("TUI application failed: %w", err)
 	}
 	
 	return nil
 }
 
-// runNonInteractiveMode handles non-interactive branch creation
-func runNonInteractiveMode(cfg *config.Config, gitRepo git.GitRepository) error {
+// newGeneratorConfig creates the branch name generator settings from the configuration
+func newGeneratorConfig(cfg *config.Config) branch.GeneratorConfig {
+	generatorConfig := branch.GeneratorConfigFromAppConfig(
+		cfg.MaxBranchLength,
+		cfg.Sanitization.Separator,
+		cfg.Sanitization.Lowercase,
+		cfg.Sanitization.RemoveUmlauts,
+	)
+	generatorConfig.Shortening = branch.ShorteningOptionsFromAppConfig(
+		cfg.Shortening.RemovePrefixes,
+		cfg.Shortening.Languages,
+		cfg.Shortening.StopWords,
+		cfg.Shortening.Abbreviations,
+	)
+	generatorConfig.Transliterator = branch.TransliteratorFromAppConfig(
+		cfg.Sanitization.Transliterate,
+		cfg.Sanitization.TransliterationLanguage,
+		cfg.Sanitization.RemoveUmlauts,
+		cfg.Sanitization.Transliterations,
+		cfg.Sanitization.KeepUnicode,
+	)
+	generatorConfig.LengthUnit = branch.LengthUnitFromAppConfig(cfg.LengthUnit)
+	return generatorConfig
+}
+
+// branchResult is the outcome of non-interactive branch creation, written by --output json
+type branchResult struct {
+	Type        string              `json:"type"`
+	Base        string              `json:"base"`
+	Ticket      string              `json:"ticket"`
+	Title       string              `json:"title,omitempty"`
+	TitleSource string              `json:"title_source"` // flag, stdin, jira or none
+	Branch      string              `json:"branch,omitempty"`
+	Created     bool                `json:"created"`
+	DryRun      bool                `json:"dry_run"`
+	Warnings    []string            `json:"warnings,omitempty"`
+	Error       *errors.ErrorReport `json:"error,omitempty"`
+}
+
+// warn records a warning and shows it in text output
+func (r *branchResult) warn(format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+	r.Warnings = append(r.Warnings, warning)
+	say("Warning: %s\n", warning)
+}
+
+// say prints progress for humans; it is left out with --output json and --quiet
+func say(format string, args ...interface{}) {
+	if outputFormat == "text" && !quiet {
+		fmt.Printf(format, args...)
+	}
+}
+
+// writeJSON writes a value as an indented JSON document
+func writeJSON(w io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// isTerminal reports whether the file is an interactive terminal rather than a pipe or file
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// runNonInteractiveMode handles non-interactive branch creation and records the outcome in result
+func runNonInteractiveMode(result *branchResult) error {
+	result.TitleSource = "none"
+	if ticketTitle != "" {
+		result.TitleSource = "flag"
+	}
+
+	if ticketFromStdin {
+		ticket, err := readTicketFromStdin(os.Stdin)
+		if err != nil {
+			return err
+		}
+		ticketNumber = ticket.Key
+		if ticketTitle == "" && ticket.Summary != "" {
+			ticketTitle = ticket.Summary
+			result.TitleSource = "stdin"
+		}
+	}
+
+	result.Type = branchType
+	result.Base = baseBranch
+	result.Ticket = ticketNumber
+	result.Title = ticketTitle
+
+	// Load configuration
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// A ticket read from stdin is usually picked interactively, e.g. with fzf, so --type is optional
+	if ticketFromStdin && branchType == "" {
+		branchType = cfg.DefaultBranchType
+		result.Type = branchType
+	}
+
+	// Initialize Git repository
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return errNotGitRepository
+	}
+
 	// Validate required flags for non-interactive mode
 	if err := validateNonInteractiveFlags(cfg); err != nil {
-		return err
+		// The messages already say which flags to use
+		return errors.NewUsageError("", err.Error())
 	}
 
 	// Get current branch as default base if not specified
@@ -202,37 +497,26 @@ func runNonInteractiveMode(cfg *config.Config, gitRepo git.GitRepository) error
 			return fmt.Errorf("failed to get current branch (ensure you're in a Git repository): %w", err)
 		}
 		baseBranch = currentBranch
-		fmt.Printf("Using current branch '%s' as base branch\n", baseBranch)
+		result.Base = baseBranch
+		say("Using current branch '%s' as base branch\n", baseBranch)
 	} else {
 		// Validate that the specified base branch exists
-		branches, err := gitRepo.GetLocalBranches()
-		if err != nil {
-			return fmt.Errorf("failed to list local branches: %w", err)
-		}
-		
-		branchExists := false
-		for _, branch := range branches {
-			if branch == baseBranch {
-				branchExists = true
-				break
-			}
-		}
-		
-		if !branchExists {
-			return fmt.Errorf("base branch '%s' does not exist locally\nAvailable branches: %s", 
-				baseBranch, strings.Join(branches, ", "))
+		if err := checkBaseBranch(gitRepo, baseBranch); err != nil {
+			return err
 		}
 	}
 
 	// Fetch ticket title from Jira if not provided and ticket number is given
 	if ticketTitle == "" && ticketNumber != "" {
-		jiraClient := jira.NewCLIClient()
+		jiraClient := newJiraClient(cfg)
 		if title, err := jiraClient.GetTicketTitle(ticketNumber); err == nil {
 			ticketTitle = title
-			fmt.Printf("Fetched title from Jira: %s\n", ticketTitle)
+			result.Title = title
+			result.TitleSource = "jira"
+			say("Fetched title from Jira: %s\n", ticketTitle)
 		} else {
-			fmt.Printf("Warning: Could not fetch title from Jira: %v\n", err)
-			fmt.Println("Proceeding without title...")
+			result.warn("Could not fetch title from Jira: %v", err)
+			say("Proceeding without title...\n")
 		}
 	}
 
@@ -244,25 +528,25 @@ func runNonInteractiveMode(cfg *config.Config, gitRepo git.GitRepository) error
 		TicketID: ticketNumber,
 		Title:    ticketTitle,
 	}
-	branchName := generator.GenerateNameWithConfig(branchInfo, branch.GeneratorConfigFromAppConfig(
-		cfg.MaxBranchLength,
-		cfg.Sanitization.Separator,
-		cfg.Sanitization.Lowercase,
-		cfg.Sanitization.RemoveUmlauts,
-	))
+	generatorConfig := newGeneratorConfig(cfg)
+	branchName := generator.GenerateNameWithConfig(branchInfo, generatorConfig)
+	result.Branch = branchName
 
 	// Display branch information
-	fmt.Printf("\nBranch Information:\n")
-	fmt.Printf("  Type: %s\n", branchType)
-	fmt.Printf("  Base Branch: %s\n", baseBranch)
-	fmt.Printf("  Ticket: %s\n", ticketNumber)
+	say("\nBranch Information:\n")
+	say("  Type: %s\n", branchType)
+	say("  Base Branch: %s\n", baseBranch)
+	say("  Ticket: %s\n", ticketNumber)
 	if ticketTitle != "" {
-		fmt.Printf("  Title: %s\n", ticketTitle)
+		say("  Title: %s\n", ticketTitle)
 	}
-	fmt.Printf("  Generated Branch: %s\n", branchName)
+	say("  Generated Branch: %s\n", branchName)
 
 	if dryRun {
-		fmt.Printf("\n✓ Dry-run complete. Branch '%s' would be created from '%s'\n", branchName, baseBranch)
+		say("\n✓ Dry-run complete. Branch '%s' would be created from '%s'\n", branchName, baseBranch)
+		if quiet {
+			fmt.Println(branchName)
+		}
 		return nil
 	}
 
@@ -274,21 +558,96 @@ func runNonInteractiveMode(cfg *config.Config, gitRepo git.GitRepository) error
 	
 	for _, branch := range branches {
 		if branch == branchName {
-			return fmt.Errorf("branch '%s' already exists\nUse a different ticket number or title", branchName)
+			return errors.NewGitError("branch", fmt.Sprintf("branch '%s' already exists", branchName), true)
 		}
 	}
 
 	// Create the branch
-	fmt.Printf("\nCreating branch '%s' from '%s'...\n", branchName, baseBranch)
+	say("\nCreating branch '%s' from '%s'...\n", branchName, baseBranch)
+	operation := undo.Begin(gitRepo, "create")
 	if err := gitRepo.CreateBranch(branchName, baseBranch); err != nil {
 		return fmt.Errorf("failed to create branch '%s': %w\nEnsure the base branch '%s' exists and you have proper Git permissions", 
 			branchName, err, baseBranch)
 	}
+	result.Created = true
+	operation.AddBranch(gitRepo, branchName, baseBranch)
+	_ = undo.Record(gitRepo, operation)
+	recordHistory(gitRepo, history.Entry{
+		Type:   branchType,
+		Base:   baseBranch,
+		Ticket: ticketNumber,
+		Title:  ticketTitle,
+		Branch: branchName,
+	})
 
-	fmt.Printf("✓ Successfully created and checked out branch '%s'\n", branchName)
+	say("✓ Successfully created and checked out branch '%s'\n", branchName)
+	if quiet {
+		fmt.Println(branchName)
+	}
 	return nil
 }
 
+// checkBaseBranch checks that a base branch exists as a local or remote-tracking branch
+func checkBaseBranch(gitRepo git.GitRepository, name string) error {
+	branches, err := gitRepo.GetLocalBranches()
+	if err != nil {
+		return fmt.Errorf("failed to list local branches: %w", err)
+	}
+	for _, branch := range branches {
+		if branch == name {
+			return nil
+		}
+	}
+
+	if remoteBranches, err := gitRepo.GetRemoteBranches(); err == nil {
+		for _, branch := range remoteBranches {
+			if branch == name {
+				return nil
+			}
+		}
+	}
+
+	return errors.NewGitError("branch", fmt.Sprintf("base branch '%s' does not exist (local branches: %s)",
+		name, strings.Join(branches, ", ")), true)
+}
+
+// readTicketFromStdin reads the ticket key and title from a "KEY title" line on standard input
+// Lines without a ticket key are ignored; more than one ticket is rejected
+func readTicketFromStdin(r io.Reader) (jira.Ticket, error) {
+	tickets, err := jira.ParseTicketList(r)
+	if err != nil {
+		return jira.Ticket{}, err
+	}
+
+	switch len(tickets) {
+	case 0:
+		return jira.Ticket{}, fmt.Errorf("no ticket key found on standard input\n  Expected a line like: PROJ-123 Add user authentication")
+	case 1:
+		return tickets[0], nil
+	default:
+		return jira.Ticket{}, fmt.Errorf("standard input contains %d tickets, expected one\n  Use 'jiraflow batch -' to create a branch for each of them", len(tickets))
+	}
+}
+
+// typeFlagUsage returns the --type flag description listing the visible branch types in display order
+func typeFlagUsage(cfg *config.Config) string {
+	return fmt.Sprintf("Branch type (%s)", strings.Join(cfg.VisibleBranchTypes(), ", "))
+}
+
+// updateTypeFlagUsage lists the branch types of the effective configuration in the --type help
+// The configuration is read without side effects; on errors the built-in types are listed
+func updateTypeFlagUsage() {
+	configManager, err := newConfigManager()
+	if err != nil {
+		return
+	}
+	cfg, _, err := configManager.LoadStrict()
+	if err != nil || len(cfg.VisibleBranchTypes()) == 0 {
+		return
+	}
+	rootCmd.Flags().Lookup("type").Usage = typeFlagUsage(cfg)
+}
+
 // validateNonInteractiveFlags validates the required flags for non-interactive mode
 func validateNonInteractiveFlags(cfg *config.Config) error {
 	var errors []string
@@ -298,31 +657,15 @@ func validateNonInteractiveFlags(cfg *config.Config) error {
 		errors = append(errors, "branch type is required (use --type flag)")
 		
 		// Provide helpful suggestion
-		validTypes := make([]string, 0, len(cfg.BranchTypes))
-		for t := range cfg.BranchTypes {
-			validTypes = append(validTypes, t)
-		}
+		validTypes := cfg.VisibleBranchTypes()
 		if len(validTypes) > 0 {
 			errors = append(errors, fmt.Sprintf("  Available types: %s", strings.Join(validTypes, ", ")))
 		}
 	} else {
-		// Check if branch type is valid
-		validTypes := make([]string, 0, len(cfg.BranchTypes))
-		for t := range cfg.BranchTypes {
-			validTypes = append(validTypes, t)
-		}
-		
-		isValid := false
-		for _, validType := range validTypes {
-			if branchType == validType {
-				isValid = true
-				break
-			}
-		}
-		
-		if !isValid {
+		// Check if branch type is valid; hidden types are accepted but not advertised
+		if _, isValid := cfg.BranchTypes[branchType]; !isValid {
 			errors = append(errors, fmt.Sprintf("invalid branch type '%s'", branchType))
-			errors = append(errors, fmt.Sprintf("  Valid types: %s", strings.Join(validTypes, ", ")))
+			errors = append(errors, fmt.Sprintf("  Valid types: %s", strings.Join(cfg.VisibleBranchTypes(), ", ")))
 		}
 	}
 
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/branch"
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+)
+
+var (
+	// Status command flags
+	statusFormat string
+)
+
+// statusCmd shows the ticket information encoded in the current branch name
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show ticket information for the current branch",
+	Long: `Parse the current branch name back into branch type, ticket key and title
+and show the related Jira ticket and base branch information.
+
+The base branch is the branch the current branch was created from by JiraFlow.
+Ahead/behind counts are relative to that base branch.
+
+Examples:
+  # Show status of the current branch
+  jiraflow status
+
+  # Machine-readable output for shell prompts and scripts
+  jiraflow status --format json`,
+	Args: cobra.NoArgs,
+	RunE: runStatus,
+}
+
+func init() {
+	statusCmd.Flags().StringVar(&statusFormat, "format", "text", "Output format (text, json)")
+	rootCmd.AddCommand(statusCmd)
+}
+
+// statusReport holds the information shown by the status command
+type statusReport struct {
+	Branch   string   `json:"branch"`
+	Type     string   `json:"type"`
+	Ticket   string   `json:"ticket"`
+	Slug     string   `json:"slug"`
+	Summary  string   `json:"summary,omitempty"`
+	Status   string   `json:"status,omitempty"`
+	Base     string   `json:"base,omitempty"`
+	Ahead    *int     `json:"ahead,omitempty"`
+	Behind   *int     `json:"behind,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// runStatus is the entry point for the status command
+func runStatus(cmd *cobra.Command, args []string) error {
+	if statusFormat != "text" && statusFormat != "json" {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("invalid format '%s' (valid formats: text, json)", statusFormat))
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return errNotGitRepository
+	}
+
+	currentBranch, err := gitRepo.GetCurrentBranch()
+	if err != nil {
+		return fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	// Recover type, ticket and title from the branch name
+	parser := branch.NewBranchParser(branch.ParserConfigFromAppConfig(cfg.BranchTypes, cfg.Sanitization.Separator))
+	parsed, err := parser.Parse(currentBranch)
+	if err != nil {
+		return fmt.Errorf("current branch was not created by JiraFlow: %w", err)
+	}
+
+	report := statusReport{
+		Branch: parsed.Name,
+		Type:   parsed.Type,
+		Ticket: parsed.TicketID,
+		Slug:   parsed.Slug,
+	}
+
+	// Fetch ticket details from Jira if available
+	jiraClient := newJiraClient(cfg)
+	if ticket, err := jiraClient.GetTicket(parsed.TicketID); err == nil {
+		report.Summary = ticket.Summary
+		report.Status = ticket.Status
+	} else {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("could not fetch ticket from Jira: %v", err))
+	}
+
+	// Compare with the base branch the branch was created from
+	if base, err := gitRepo.GetBranchBase(currentBranch); err == nil {
+		report.Base = base
+		if ahead, behind, err := gitRepo.GetAheadBehind(currentBranch, base); err == nil {
+			report.Ahead = &ahead
+			report.Behind = &behind
+		} else {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("could not compare with base branch: %v", err))
+		}
+	}
+
+	if statusFormat == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	printStatusReport(report)
+	return nil
+}
+
+// printStatusReport prints the status report in human readable form
+func printStatusReport(report statusReport) {
+	fmt.Printf("Branch:  %s\n", report.Branch)
+	fmt.Printf("Type:    %s\n", report.Type)
+	fmt.Printf("Ticket:  %s\n", report.Ticket)
+	if report.Summary != "" {
+		fmt.Printf("Summary: %s\n", report.Summary)
+	}
+	if report.Status != "" {
+		fmt.Printf("Status:  %s\n", report.Status)
+	}
+	if report.Base != "" {
+		if report.Ahead != nil && report.Behind != nil {
+			fmt.Printf("Base:    %s (%d ahead, %d behind)\n", report.Base, *report.Ahead, *report.Behind)
+		} else {
+			fmt.Printf("Base:    %s\n", report.Base)
+		}
+	} else {
+		fmt.Printf("Base:    unknown\n")
+	}
+
+	for _, warning := range report.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+}
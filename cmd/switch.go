@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/branch"
+	"jiraflow/internal/config"
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+	"jiraflow/internal/tui"
+)
+
+var (
+	// Switch command flags
+	switchRemote bool
+)
+
+// ticketKeyRegex validates a Jira ticket key given on the command line
+var ticketKeyRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]*-\d+$`)
+
+// switchCmd checks out the branch belonging to a ticket
+var switchCmd = &cobra.Command{
+	Use:   "switch <ticket>",
+	Short: "Switch to the branch of a Jira ticket",
+	Long: `Find the branch created for a Jira ticket and check it out.
+
+Branch names are parsed using the configured branch types and separator, and
+every branch whose ticket key matches is considered. When several branches
+match, a picker is shown. When no branch matches, you are offered to create
+one using the interactive flow with the ticket pre-filled. Without a terminal
+the matches are listed, or the missing branch reported, and the command fails
+instead of asking.
+
+Examples:
+  # Switch to the local branch of PROJ-123
+  jiraflow switch PROJ-123
+
+  # Also consider remote branches (creates a local tracking branch)
+  jiraflow switch --remote PROJ-123`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeBranchTicket,
+	RunE:              runSwitch,
+}
+
+func init() {
+	switchCmd.Flags().BoolVarP(&switchRemote, "remote", "r", false, "Also search remote branches")
+	rootCmd.AddCommand(switchCmd)
+}
+
+// runSwitch is the entry point for the switch command
+func runSwitch(cmd *cobra.Command, args []string) error {
+	ticket := strings.ToUpper(strings.TrimSpace(args[0]))
+	if !ticketKeyRegex.MatchString(ticket) {
+		return errors.NewUsageError(cmd.CommandPath(), fmt.Sprintf("invalid ticket format '%s'\n  Expected format: PROJECT-NUMBER (e.g., PROJ-123)", args[0]))
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return errNotGitRepository
+	}
+
+	matches, err := findTicketBranches(cfg, gitRepo, ticket, switchRemote)
+	if err != nil {
+		return err
+	}
+
+	switch len(matches) {
+	case 0:
+		return offerBranchCreation(cfg, gitRepo, ticket)
+	case 1:
+		return switchToBranch(gitRepo, matches[0])
+	}
+
+	// Without a terminal the picker would wait for input that never comes
+	if !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+		names := make([]string, 0, len(matches))
+		for _, match := range matches {
+			names = append(names, match.Name)
+		}
+		return fmt.Errorf("%d branches match ticket %s:\n  %s\nRun 'jiraflow switch' in a terminal to pick one, or check one out with git", len(matches), ticket, strings.Join(names, "\n  "))
+	}
+
+	selected, err := tui.RunBranchPicker(fmt.Sprintf("Select Branch for %s", ticket), matches)
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		if match.Name == selected {
+			return switchToBranch(gitRepo, match)
+		}
+	}
+
+	return fmt.Errorf("selected branch '%s' not found", selected)
+}
+
+// findTicketBranches returns all branches whose parsed ticket key matches the ticket
+// Remote branches that already have a local counterpart are skipped
+func findTicketBranches(cfg *config.Config, gitRepo git.GitRepository, ticket string, includeRemote bool) ([]git.BranchInfo, error) {
+	parser := branch.NewBranchParser(branch.ParserConfigFromAppConfig(cfg.BranchTypes, cfg.Sanitization.Separator))
+
+	localBranches, err := gitRepo.GetBranchesWithInfo()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local branches: %w", err)
+	}
+
+	var matches []git.BranchInfo
+	localNames := make(map[string]bool)
+	for _, info := range localBranches {
+		localNames[info.Name] = true
+		if parsed, err := parser.Parse(info.Name); err == nil && parsed.TicketID == ticket {
+			matches = append(matches, info)
+		}
+	}
+
+	if !includeRemote {
+		return matches, nil
+	}
+
+	remoteBranches, err := gitRepo.GetRemoteBranches()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote branches: %w", err)
+	}
+
+	for _, name := range remoteBranches {
+		_, localName := git.SplitRemoteBranch(name)
+		if localNames[localName] {
+			continue
+		}
+		if parsed, err := parser.Parse(localName); err == nil && parsed.TicketID == ticket {
+			matches = append(matches, git.BranchInfo{Name: name, IsRemote: true})
+		}
+	}
+
+	return matches, nil
+}
+
+// switchToBranch checks out a matched branch
+// Remote branches are checked out by their local name so Git creates a tracking branch
+func switchToBranch(gitRepo git.GitRepository, info git.BranchInfo) error {
+	if info.IsCurrent {
+		fmt.Printf("Already on '%s'\n", info.Name)
+		return nil
+	}
+
+	name := info.Name
+	if info.IsRemote {
+		_, name = git.SplitRemoteBranch(info.Name)
+	}
+
+	if err := gitRepo.CheckoutBranch(name); err != nil {
+		return fmt.Errorf("failed to switch to branch '%s': %w", name, err)
+	}
+
+	fmt.Printf("✓ Switched to branch '%s'\n", name)
+	return nil
+}
+
+// offerBranchCreation asks whether to create a branch for the ticket using the interactive flow
+func offerBranchCreation(cfg *config.Config, gitRepo git.GitRepository, ticket string) error {
+	// Without a terminal neither the question nor the TUI can be answered
+	if !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+		message := fmt.Sprintf("no branch found for ticket %s", ticket)
+		if !switchRemote {
+			message += "; use --remote to also search remote branches"
+		}
+		return fmt.Errorf("%s\nCreate one with 'jiraflow --type <type> --ticket %s'", message, ticket)
+	}
+
+	fmt.Printf("No branch found for ticket %s.\n", ticket)
+	if !switchRemote {
+		fmt.Println("Use --remote to also search remote branches.")
+	}
+	fmt.Print("Create one now? [y/N] ")
+
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer != "y" && answer != "yes" {
+		return nil
+	}
+
+	generatorConfig := newGeneratorConfig(cfg)
+	if err := tui.RunTUIWithOptions(cfg, gitRepo, tui.Options{TicketNumber: ticket, Generator: &generatorConfig}); err != nil {
+		return fmt.Errorf("TUI application failed: %w", err)
+	}
+
+	return nil
+}
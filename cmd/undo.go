@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+	"jiraflow/internal/undo"
+)
+
+var (
+	// Undo command flags
+	undoRemote bool
+	undoForce  bool
+	undoYes    bool
+)
+
+// undoCmd reverts the last branch creation in the current repository
+var undoCmd = &cobra.Command{
+	Use:   "undo",
+	Short: "Undo the last branch creation in this repository",
+	Long: `Undo the last JiraFlow operation of the current repository, e.g. after
+picking the wrong base branch.
+
+Every branch creation, interactive, non-interactive or by the batch command,
+records the branch checked out before and the commit each created branch
+pointed to. Undo switches back to the previous branch if a created branch is
+checked out, carrying uncommitted changes over with a temporary stash, and
+deletes the created branches.
+
+Branches with commits made after their creation are kept unless --force is
+given. Branches that were pushed keep their remote branch unless --remote is
+given. The steps are shown and confirmed before anything is changed; use
+--dry-run to only show them.
+
+Examples:
+  # Undo the branch just created
+  jiraflow undo
+
+  # Also delete the branch that was already pushed
+  jiraflow undo --remote
+
+  # Show what would be undone
+  jiraflow undo --dry-run`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runUndo,
+}
+
+func init() {
+	undoCmd.Flags().BoolVar(&undoRemote, "remote", false, "Also delete pushed branches on their remote")
+	undoCmd.Flags().BoolVar(&undoForce, "force", false, "Delete branches even if they have new commits")
+	undoCmd.Flags().BoolVarP(&undoYes, "yes", "y", false, "Undo without confirmation")
+	rootCmd.AddCommand(undoCmd)
+}
+
+// runUndo is the entry point for the undo command
+func runUndo(cmd *cobra.Command, args []string) error {
+	gitRepo := git.NewLocalGitRepository()
+	if !gitRepo.IsGitRepository() {
+		return errNotGitRepository
+	}
+
+	operation, err := undo.Load(gitRepo)
+	if err != nil {
+		return fmt.Errorf("failed to read the last operation: %w", err)
+	}
+	if operation == nil {
+		fmt.Println("Nothing to undo")
+		return nil
+	}
+
+	actions, notes, err := undo.Plan(gitRepo, *operation, undo.Options{DeleteRemote: undoRemote, Force: undoForce})
+	if err != nil {
+		return err
+	}
+
+	printOperation(*operation)
+	for _, note := range notes {
+		fmt.Printf("Note: %s\n", note)
+	}
+	if len(actions) == 0 {
+		fmt.Println("Nothing left to undo")
+		return undo.Clear(gitRepo)
+	}
+
+	fmt.Println("\nUndo will:")
+	for _, action := range actions {
+		fmt.Printf("  - %s\n", action)
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	if !undoYes {
+		if !isTerminal(os.Stdin) {
+			return errors.NewUsageError(cmd.CommandPath(), "confirmation requires a terminal; pass --yes to undo")
+		}
+		fmt.Print("\nContinue? [y/N] ")
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		answer = strings.ToLower(strings.TrimSpace(answer))
+		if answer != "y" && answer != "yes" {
+			return nil
+		}
+	}
+
+	if err := undo.Run(gitRepo, *operation, actions); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ Undone")
+	return nil
+}
+
+// printOperation describes the recorded operation
+func printOperation(operation undo.Operation) {
+	fmt.Printf("Last operation (%s, %s):\n", operation.Command, operation.Time.Local().Format("2006-01-02 15:04"))
+	for _, branch := range operation.Branches {
+		fmt.Printf("  created '%s' from '%s'\n", branch.Name, branch.Base)
+	}
+	if previous := operation.Previous(); previous != "" {
+		fmt.Printf("  previously on '%s'\n", previous)
+	}
+}
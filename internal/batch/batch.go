@@ -0,0 +1,112 @@
+package batch
+
+import (
+	"fmt"
+
+	"jiraflow/internal/git"
+	"jiraflow/internal/jira"
+)
+
+// Status is the state of a branch in a batch
+type Status string
+
+const (
+	// StatusPending marks a branch that will be created
+	StatusPending Status = "pending"
+	// StatusExists marks a branch that already exists and is left alone
+	StatusExists Status = "exists"
+	// StatusDuplicate marks a branch whose name was already generated for another ticket
+	StatusDuplicate Status = "duplicate"
+	// StatusSkipped marks a branch that was deselected during review
+	StatusSkipped Status = "skipped"
+	// StatusCreated marks a branch that was created
+	StatusCreated Status = "created"
+	// StatusFailed marks a branch that could not be created
+	StatusFailed Status = "failed"
+)
+
+// Item is a branch to be created for one ticket
+type Item struct {
+	Ticket  string `json:"ticket"`
+	Title   string `json:"title,omitempty"`
+	Branch  string `json:"branch"`
+	Status  Status `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Plan creates the batch items for the tickets, with branch names generated by generate
+// Repeated tickets are merged, keeping the first title given; branches that already
+// exist or were generated twice are marked and will not be created
+func Plan(tickets []jira.Ticket, existing []string, generate func(jira.Ticket) string) []Item {
+	existingNames := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		existingNames[name] = true
+	}
+
+	var merged []jira.Ticket
+	index := make(map[string]int)
+	for _, ticket := range tickets {
+		if i, seen := index[ticket.Key]; seen {
+			if merged[i].Summary == "" {
+				merged[i].Summary = ticket.Summary
+			}
+			continue
+		}
+		index[ticket.Key] = len(merged)
+		merged = append(merged, ticket)
+	}
+
+	items := make([]Item, 0, len(merged))
+	generated := make(map[string]string)
+	for _, ticket := range merged {
+		item := Item{
+			Ticket: ticket.Key,
+			Title:  ticket.Summary,
+			Branch: generate(ticket),
+			Status: StatusPending,
+		}
+		switch other, duplicate := generated[item.Branch]; {
+		case existingNames[item.Branch]:
+			item.Status = StatusExists
+			item.Message = "branch already exists"
+		case duplicate:
+			item.Status = StatusDuplicate
+			item.Message = fmt.Sprintf("same branch name as %s", other)
+		default:
+			generated[item.Branch] = ticket.Key
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// Create creates the branches of all pending items from the base branch without checking them out
+// It returns the number of branches that could not be created
+func Create(repo git.GitRepository, items []Item, base string) int {
+	failed := 0
+	for i := range items {
+		if items[i].Status != StatusPending {
+			continue
+		}
+		if err := repo.CreateBranchWithoutCheckout(items[i].Branch, base); err != nil {
+			items[i].Status = StatusFailed
+			items[i].Message = err.Error()
+			failed++
+			continue
+		}
+		items[i].Status = StatusCreated
+	}
+	return failed
+}
+
+// Count returns the number of items with the given status
+func Count(items []Item, status Status) int {
+	count := 0
+	for _, item := range items {
+		if item.Status == status {
+			count++
+		}
+	}
+	return count
+}
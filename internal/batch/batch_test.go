@@ -0,0 +1,87 @@
+package batch
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"jiraflow/internal/git"
+	"jiraflow/internal/jira"
+)
+
+// fakeRepository records created branches and fails for names listed in fail
+type fakeRepository struct {
+	git.GitRepository
+	created []string
+	fail    map[string]bool
+}
+
+func (r *fakeRepository) CreateBranchWithoutCheckout(name, baseBranch string) error {
+	if r.fail[name] {
+		return fmt.Errorf("cannot create %s", name)
+	}
+	r.created = append(r.created, name+"<"+baseBranch)
+	return nil
+}
+
+// generateName builds a simple branch name from the ticket key
+func generateName(ticket jira.Ticket) string {
+	return "feature/" + ticket.Key
+}
+
+func TestPlan(t *testing.T) {
+	tickets := []jira.Ticket{
+		{Key: "PROJ-1"},
+		{Key: "PROJ-2", Summary: "Existing"},
+		{Key: "PROJ-1", Summary: "Add login"},
+		{Key: "PROJ-3"},
+	}
+	generate := func(ticket jira.Ticket) string {
+		if ticket.Key == "PROJ-3" {
+			return "feature/PROJ-1"
+		}
+		return generateName(ticket)
+	}
+
+	items := Plan(tickets, []string{"main", "feature/PROJ-2"}, generate)
+	want := []Item{
+		{Ticket: "PROJ-1", Title: "Add login", Branch: "feature/PROJ-1", Status: StatusPending},
+		{Ticket: "PROJ-2", Title: "Existing", Branch: "feature/PROJ-2", Status: StatusExists, Message: "branch already exists"},
+		{Ticket: "PROJ-3", Branch: "feature/PROJ-1", Status: StatusDuplicate, Message: "same branch name as PROJ-1"},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("Plan() = %+v, want %+v", items, want)
+	}
+	for i := range want {
+		if items[i] != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, items[i], want[i])
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	items := Plan([]jira.Ticket{{Key: "PROJ-1"}, {Key: "PROJ-2"}, {Key: "PROJ-3"}, {Key: "PROJ-4"}}, []string{"feature/PROJ-4"}, generateName)
+	items[1].Status = StatusSkipped
+	repo := &fakeRepository{fail: map[string]bool{"feature/PROJ-3": true}}
+
+	failed := Create(repo, items, "develop")
+	if failed != 1 {
+		t.Errorf("Create() failed = %d, want 1", failed)
+	}
+	if strings.Join(repo.created, ",") != "feature/PROJ-1<develop" {
+		t.Errorf("created = %v, want only feature/PROJ-1 from develop", repo.created)
+	}
+
+	wantStatus := []Status{StatusCreated, StatusSkipped, StatusFailed, StatusExists}
+	for i, status := range wantStatus {
+		if items[i].Status != status {
+			t.Errorf("item %d status = %s, want %s", i, items[i].Status, status)
+		}
+	}
+	if !strings.Contains(items[2].Message, "cannot create") {
+		t.Errorf("failed item message = %q, want the error", items[2].Message)
+	}
+	if Count(items, StatusCreated) != 1 || Count(items, StatusPending) != 0 {
+		t.Errorf("Count() created = %d, pending = %d", Count(items, StatusCreated), Count(items, StatusPending))
+	}
+}
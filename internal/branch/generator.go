@@ -2,7 +2,6 @@ package branch
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
 )
 
@@ -12,6 +11,9 @@ type GeneratorConfig struct {
 	Separator       string
 	Lowercase       bool
 	RemoveUmlauts   bool
+	Shortening      *ShorteningOptions // nil disables word-level shortening
+	Transliterator  *Transliterator    // nil only handles German umlauts (RemoveUmlauts)
+	LengthUnit      LengthUnit         // how MaxBranchLength is counted
 }
 
 // BranchInfo represents information needed to generate a branch name
@@ -27,6 +29,7 @@ type BranchInfo struct {
 type Generator interface {
 	GenerateName(info BranchInfo) string
 	ValidateName(name string) error
+	FixName(name string) string
 }
 
 // BranchGenerator implements the Generator interface
@@ -66,7 +69,8 @@ func (g *BranchGenerator) GenerateNameWithConfig(info BranchInfo, config Generat
 
 	// Calculate available length for title part
 	// Format: type/ticket-title, so we need to account for type, slash, ticket, and separator
-	prefixLength := len(info.Type) + 1 + len(info.TicketID) + len(config.Separator) // type + "/" + ticket + separator
+	unit := config.LengthUnit
+	prefixLength := unit.Len(info.Type) + 1 + unit.Len(info.TicketID) + unit.Len(config.Separator) // type + "/" + ticket + separator
 	availableTitleLength := config.MaxBranchLength - prefixLength
 
 	// Ensure we have at least some space for the title
@@ -74,65 +78,69 @@ func (g *BranchGenerator) GenerateNameWithConfig(info BranchInfo, config Generat
 		availableTitleLength = 10 // Minimum title length
 	}
 
-	// Sanitize the title using the sanitizer
-	sanitizedTitle := g.sanitizer.Sanitize(title, SanitizationOptions{
-		Separator:     config.Separator,
-		Lowercase:     config.Lowercase,
-		RemoveUmlauts: config.RemoveUmlauts,
-		MaxLength:     availableTitleLength,
-	})
+	// Sanitize the title using the sanitizer, shortening it word by word if configured
+	sanitizationOptions := SanitizationOptions{
+		Separator:      config.Separator,
+		Lowercase:      config.Lowercase,
+		RemoveUmlauts:  config.RemoveUmlauts,
+		MaxLength:      availableTitleLength,
+		Transliterator: config.Transliterator,
+		LengthUnit:     unit,
+	}
+	var sanitizedTitle string
+	if config.Shortening == nil {
+		sanitizedTitle = g.sanitizer.Sanitize(title, sanitizationOptions)
+	} else {
+		sanitizedTitle = ShortenTitle(title, g.sanitizer, sanitizationOptions, *config.Shortening, availableTitleLength)
+	}
 
 	// Create the branch name in format: type/ticket-title
 	branchName := fmt.Sprintf("%s/%s%s%s", info.Type, info.TicketID, config.Separator, sanitizedTitle)
 
 	// Final length check and truncation if needed
-	if len(branchName) > config.MaxBranchLength {
+	if unit.Len(branchName) > config.MaxBranchLength {
 		// Truncate from the title part while preserving the format
 		maxTitleLength := config.MaxBranchLength - prefixLength
 		if maxTitleLength > 0 {
 			truncatedTitle := sanitizedTitle
-			if len(sanitizedTitle) > maxTitleLength {
-				truncatedTitle = sanitizedTitle[:maxTitleLength]
-				// Remove trailing separator if truncation created one
-				truncatedTitle = strings.TrimSuffix(truncatedTitle, config.Separator)
+			if unit.Len(sanitizedTitle) > maxTitleLength {
+				truncatedTitle = unit.Truncate(sanitizedTitle, maxTitleLength)
+				// Remove trailing separator or dot if truncation created one
+				truncatedTitle = strings.TrimRight(strings.TrimSuffix(truncatedTitle, config.Separator), ".")
 			}
 			branchName = fmt.Sprintf("%s/%s%s%s", info.Type, info.TicketID, config.Separator, truncatedTitle)
 		}
 	}
 
+	// Ticket IDs and branch types are not sanitized, repair anything Git would reject
+	if len(CheckRefFormat(branchName)) > 0 {
+		branchName = FixRefName(branchName)
+	}
+
 	return branchName
 }
 
-// ValidateName validates a branch name according to Git naming rules
+// ValidateName validates a branch name according to the rules of git check-ref-format --branch
+// The returned error is a *RefFormatError listing every violation
 func (g *BranchGenerator) ValidateName(name string) error {
-	if name == "" {
-		return fmt.Errorf("branch name cannot be empty")
+	if violations := CheckRefFormat(name); len(violations) > 0 {
+		return &RefFormatError{Name: name, Violations: violations}
 	}
+	return nil
+}
 
-	// Git branch name validation rules
-	invalidPatterns := []string{
-		`^\.`,           // Cannot start with dot
-		`\.$`,           // Cannot end with dot
-		`\.\.`,          // Cannot contain double dots
-		`^/`,            // Cannot start with slash
-		`/$`,            // Cannot end with slash
-		`//`,            // Cannot contain double slashes
-		`\s`,            // Cannot contain spaces
-		`[\x00-\x1f\x7f]`, // Cannot contain control characters
-		`[~^:?*\[]`,     // Cannot contain special Git characters
-	}
+// FixName repairs a branch name to the nearest name Git accepts, see FixRefName
+func (g *BranchGenerator) FixName(name string) string {
+	return FixRefName(name)
+}
 
-	for _, pattern := range invalidPatterns {
-		matched, err := regexp.MatchString(pattern, name)
-		if err != nil {
-			return fmt.Errorf("error validating branch name: %v", err)
-		}
-		if matched {
-			return fmt.Errorf("invalid branch name: contains invalid pattern %s", pattern)
-		}
+// ShorteningOptionsFromAppConfig creates ShorteningOptions for GeneratorConfig from application config
+func ShorteningOptionsFromAppConfig(removePrefixes bool, languages []string, stopWords map[string][]string, abbreviations map[string]string) *ShorteningOptions {
+	return &ShorteningOptions{
+		RemovePrefixes: removePrefixes,
+		StopWords:      StopWordsFor(languages, stopWords),
+		Abbreviations:  abbreviations,
 	}
-
-	return nil
 }
 
 // GeneratorConfigFromAppConfig creates a GeneratorConfig from application config
@@ -0,0 +1,58 @@
+package branch
+
+import (
+	"github.com/rivo/uniseg"
+)
+
+// LengthUnit selects how the length of branch names is counted
+type LengthUnit int
+
+const (
+	// LengthBytes counts bytes, which is what file systems and Git hosting limits see
+	LengthBytes LengthUnit = iota
+	// LengthCharacters counts user-perceived characters (grapheme clusters), so "é" or "修" count once
+	LengthCharacters
+)
+
+// LengthUnitFromAppConfig converts the length_unit setting, defaulting to bytes
+func LengthUnitFromAppConfig(unit string) LengthUnit {
+	if unit == "characters" {
+		return LengthCharacters
+	}
+	return LengthBytes
+}
+
+// Len returns the length of s in this unit
+func (u LengthUnit) Len(s string) int {
+	if u == LengthCharacters {
+		return uniseg.GraphemeClusterCount(s)
+	}
+	return len(s)
+}
+
+// Truncate returns the longest prefix of s that fits maxLength in this unit
+// It never splits a character: multi-byte runes and combined characters are kept whole or dropped
+func (u LengthUnit) Truncate(s string, maxLength int) string {
+	if maxLength <= 0 {
+		return ""
+	}
+	if u.Len(s) <= maxLength {
+		return s
+	}
+
+	length, end := 0, 0
+	graphemes := uniseg.NewGraphemes(s)
+	for graphemes.Next() {
+		start, stop := graphemes.Positions()
+		size := stop - start
+		if u == LengthCharacters {
+			size = 1
+		}
+		if length+size > maxLength {
+			break
+		}
+		length += size
+		end = stop
+	}
+	return s[:end]
+}
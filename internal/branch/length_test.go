@@ -0,0 +1,163 @@
+package branch
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestLengthUnit_Len(t *testing.T) {
+	tests := []struct {
+		input      string
+		bytes      int
+		characters int
+	}{
+		{"", 0, 0},
+		{"fix-login", 9, 9},
+		{"größe", 7, 5},
+		{"修复-bug", 10, 6},
+		{"café", 6, 4},
+		{"👍🏽-ok", 11, 4},
+	}
+
+	for _, tt := range tests {
+		if got := LengthBytes.Len(tt.input); got != tt.bytes {
+			t.Errorf("LengthBytes.Len(%q) = %d, want %d", tt.input, got, tt.bytes)
+		}
+		if got := LengthCharacters.Len(tt.input); got != tt.characters {
+			t.Errorf("LengthCharacters.Len(%q) = %d, want %d", tt.input, got, tt.characters)
+		}
+	}
+}
+
+func TestLengthUnit_Truncate(t *testing.T) {
+	tests := []struct {
+		name      string
+		unit      LengthUnit
+		input     string
+		maxLength int
+		expected  string
+	}{
+		{"fits", LengthBytes, "abc", 3, "abc"},
+		{"ascii bytes", LengthBytes, "abcdef", 4, "abcd"},
+		{"does not split runes", LengthBytes, "größe", 3, "gr"},
+		{"does not split combined characters", LengthBytes, "cafés", 5, "caf"},
+		{"characters", LengthCharacters, "修复登录错误", 2, "修复"},
+		{"combined characters count once", LengthCharacters, "cafés", 4, "café"},
+		{"zero", LengthCharacters, "abc", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.unit.Truncate(tt.input, tt.maxLength); got != tt.expected {
+				t.Errorf("Truncate(%q, %d) = %q, want %q", tt.input, tt.maxLength, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLengthUnitFromAppConfig(t *testing.T) {
+	if LengthUnitFromAppConfig("characters") != LengthCharacters {
+		t.Error("characters should count characters")
+	}
+	for _, unit := range []string{"bytes", ""} {
+		if LengthUnitFromAppConfig(unit) != LengthBytes {
+			t.Errorf("%q should count bytes", unit)
+		}
+	}
+}
+
+func TestBranchGenerator_LengthUnit(t *testing.T) {
+	generator := NewBranchGenerator(NewBranchSanitizer())
+	info := BranchInfo{Type: "feature", TicketID: "ZH-1", Title: "修复登录页面的错误 when saving"}
+
+	config := GeneratorConfig{
+		MaxBranchLength: 20,
+		Separator:       "-",
+		Lowercase:       true,
+		Transliterator:  NewTransliterator("", nil, true),
+	}
+
+	result := generator.GenerateNameWithConfig(info, config)
+	if result != "feature/ZH-1-修复" || !utf8.ValidString(result) {
+		t.Errorf("GenerateNameWithConfig() counting bytes = %q, want %q", result, "feature/ZH-1-修复")
+	}
+
+	config.LengthUnit = LengthCharacters
+	result = generator.GenerateNameWithConfig(info, config)
+	if result != "feature/ZH-1-修复登录页面的" {
+		t.Errorf("GenerateNameWithConfig() counting characters = %q, want %q", result, "feature/ZH-1-修复登录页面的")
+	}
+}
+
+// FuzzSanitize checks that sanitized titles are valid UTF-8 and respect the maximum length
+func FuzzSanitize(f *testing.F) {
+	f.Add("Fix login bug", 10, false)
+	f.Add("Größe ändern für Übersicht", 12, false)
+	f.Add("修复登录页面的错误", 7, true)
+	f.Add("café ..version.. 1.2.", 9, true)
+	f.Add("\xff\xfe broken \xc3", 5, false)
+
+	sanitizer := NewBranchSanitizer()
+	f.Fuzz(func(t *testing.T, title string, maxLength int, characters bool) {
+		if maxLength < 0 || maxLength > 200 {
+			return
+		}
+		unit := LengthBytes
+		if characters {
+			unit = LengthCharacters
+		}
+
+		result := sanitizer.Sanitize(title, SanitizationOptions{
+			Separator:      "-",
+			Lowercase:      true,
+			MaxLength:      maxLength,
+			Transliterator: NewTransliterator("", nil, characters),
+			LengthUnit:     unit,
+		})
+		if !utf8.ValidString(result) {
+			t.Fatalf("Sanitize(%q) = %q is not valid UTF-8", title, result)
+		}
+		if maxLength > 0 && unit.Len(result) > maxLength {
+			t.Fatalf("Sanitize(%q) = %q is longer than %d", title, result, maxLength)
+		}
+	})
+}
+
+// FuzzGenerateName checks that generated branch names are valid UTF-8, fit the maximum length and pass ValidateName
+func FuzzGenerateName(f *testing.F) {
+	f.Add("Add user authentication", 60, false, false)
+	f.Add("Füge neue Funktionalität hinzü", 30, false, false)
+	f.Add("修复登录页面的错误 when saving", 20, true, true)
+	f.Add("Исправить ошибку авторизации пользователя", 25, true, false)
+	f.Add("[BE] v1..2 release. notes.", 24, false, true)
+	f.Add("\xe4\xbf broken \xc3\xa4", 20, true, false)
+
+	generator := NewBranchGenerator(NewBranchSanitizer())
+	f.Fuzz(func(t *testing.T, title string, maxLength int, keepUnicode, characters bool) {
+		// Below 20 the "feature/PROJ-123-" prefix leaves no room for the title
+		if maxLength < 20 || maxLength > 200 {
+			return
+		}
+		config := GeneratorConfig{
+			MaxBranchLength: maxLength,
+			Separator:       "-",
+			Lowercase:       true,
+			Shortening:      &ShorteningOptions{RemovePrefixes: true, StopWords: StopWordsFor([]string{"en"}, nil)},
+			Transliterator:  NewTransliterator("", nil, keepUnicode),
+		}
+		if characters {
+			config.LengthUnit = LengthCharacters
+		}
+
+		name := generator.GenerateNameWithConfig(BranchInfo{Type: "feature", TicketID: "PROJ-123", Title: title}, config)
+		if !utf8.ValidString(name) {
+			t.Fatalf("GenerateNameWithConfig(%q) = %q is not valid UTF-8", title, name)
+		}
+		if config.LengthUnit.Len(name) > maxLength {
+			t.Fatalf("GenerateNameWithConfig(%q) = %q is longer than %d", title, name, maxLength)
+		}
+		if err := generator.ValidateName(name); err != nil {
+			t.Fatalf("GenerateNameWithConfig(%q) = %q: %v", title, name, err)
+		}
+	})
+}
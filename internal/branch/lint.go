@@ -0,0 +1,174 @@
+package branch
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Lint rules reported in addition to the RefRule values of CheckRefFormat
+const (
+	LintRuleType     = "type"     // the name does not start with a configured branch type
+	LintRuleTicket   = "ticket"   // no ticket key follows the branch type
+	LintRuleTemplate = "template" // the name does not follow type/TICKET-title
+	LintRuleSlug     = "slug"     // the title part is not sanitized like generated names
+	LintRuleLength   = "length"   // the name is longer than the maximum branch length
+)
+
+// ticketSearchPattern finds a Jira ticket key anywhere in a branch name
+var ticketSearchPattern = regexp.MustCompile(`(^|[^A-Za-z0-9])([A-Za-z][A-Za-z0-9]*-[0-9]+)`)
+
+// LintConfig holds the naming policy branch names are checked against
+type LintConfig struct {
+	Parser      ParserConfig
+	DefaultType string
+	Generator   GeneratorConfig
+	Ignore      []string // glob patterns of branch names that are not checked
+}
+
+// LintViolation is a naming policy rule broken by a branch name
+type LintViolation struct {
+	Rule     string `json:"rule"`
+	Message  string `json:"message"`
+	Position int    `json:"position"`
+}
+
+// LintResult is the outcome of checking one branch name
+type LintResult struct {
+	Branch     string          `json:"branch"`
+	Valid      bool            `json:"valid"`
+	Ignored    bool            `json:"ignored,omitempty"`
+	Violations []LintViolation `json:"violations,omitempty"`
+	Suggestion string          `json:"suggestion,omitempty"`
+}
+
+// Linter checks branch names against the naming policy
+type Linter struct {
+	config    LintConfig
+	parser    *BranchParser
+	generator *BranchGenerator
+	sanitizer Sanitizer
+}
+
+// NewLinter creates a new Linter instance
+func NewLinter(config LintConfig) *Linter {
+	sanitizer := NewBranchSanitizer()
+	return &Linter{
+		config:    config,
+		parser:    NewBranchParser(config.Parser),
+		generator: NewBranchGenerator(sanitizer),
+		sanitizer: sanitizer,
+	}
+}
+
+// Lint checks a branch name against the configured types, the type/TICKET-title template,
+// the ticket key format, sanitization, the maximum length and the Git ref rules
+// Names matching an ignore pattern are reported as valid and ignored
+func (l *Linter) Lint(name string) LintResult {
+	result := LintResult{Branch: name}
+	for _, pattern := range l.config.Ignore {
+		if matched, _ := path.Match(pattern, name); matched {
+			result.Valid = true
+			result.Ignored = true
+			return result
+		}
+	}
+
+	separator := l.parser.Separator()
+
+	// Recover type, ticket key and slug the same way the parser does
+	branchType, rest, ok := l.parser.MatchType(name)
+	ticket, slug := "", ""
+	if !ok {
+		result.add(LintRuleType, 0, fmt.Sprintf("does not start with a branch type (%s)", strings.Join(l.config.Parser.BranchTypes, "/, ")+"/"))
+	} else {
+		ticket = ticketKeyPattern.FindString(rest)
+		position := len(branchType) + 1
+		switch {
+		case ticket == "":
+			result.add(LintRuleTicket, position, fmt.Sprintf("no ticket key like PROJ-123 after '%s/'", branchType))
+		case rest != ticket && !strings.HasPrefix(rest[len(ticket):], separator):
+			slug = rest[len(ticket):]
+			result.add(LintRuleTemplate, position+len(ticket), fmt.Sprintf("ticket key '%s' must be followed by '%s' and the title", ticket, separator))
+		default:
+			slug = strings.TrimPrefix(rest[len(ticket):], separator)
+			if expected := l.sanitizeSlug(slug); slug != "" && expected != slug {
+				result.add(LintRuleSlug, position+len(ticket)+len(separator), fmt.Sprintf("title '%s' is not sanitized, expected '%s'", slug, expected))
+			}
+		}
+	}
+
+	unit := l.config.Generator.LengthUnit
+	if length := unit.Len(name); length > l.config.Generator.MaxBranchLength && l.config.Generator.MaxBranchLength > 0 {
+		result.add(LintRuleLength, len(unit.Truncate(name, l.config.Generator.MaxBranchLength)),
+			fmt.Sprintf("%d long, the maximum is %d", length, l.config.Generator.MaxBranchLength))
+	}
+
+	for _, violation := range CheckRefFormat(name) {
+		result.add(string(violation.Rule), violation.Position, violation.Message)
+	}
+
+	result.Valid = len(result.Violations) == 0
+	if !result.Valid {
+		result.Suggestion = l.suggest(name, branchType, ticket, slug)
+	}
+	return result
+}
+
+// add appends a violation to the result
+func (r *LintResult) add(rule string, position int, message string) {
+	r.Violations = append(r.Violations, LintViolation{Rule: rule, Message: message, Position: position})
+}
+
+// sanitizeSlug returns the slug as the generator would have produced it
+func (l *Linter) sanitizeSlug(slug string) string {
+	separator := l.parser.Separator()
+	return l.sanitizer.Sanitize(strings.ReplaceAll(slug, separator, " "), SanitizationOptions{
+		Separator:      separator,
+		Lowercase:      l.config.Generator.Lowercase,
+		RemoveUmlauts:  l.config.Generator.RemoveUmlauts,
+		Transliterator: l.config.Generator.Transliterator,
+		LengthUnit:     l.config.Generator.LengthUnit,
+	})
+}
+
+// suggest returns the conforming name closest to a non-conforming one, or "" without a ticket key
+func (l *Linter) suggest(name, branchType, ticket, slug string) string {
+	rest := name
+	if branchType == "" {
+		branchType = l.config.DefaultType
+	} else {
+		rest = strings.TrimPrefix(name, branchType+"/")
+	}
+
+	if ticket == "" {
+		match := ticketSearchPattern.FindStringSubmatchIndex(rest)
+		if match == nil {
+			return ""
+		}
+		// Text before the ticket key is kept as part of the title, unless it is a path prefix like "wip/"
+		before := rest[:match[4]]
+		if slash := strings.LastIndex(before, "/"); slash >= 0 {
+			before = before[slash+1:]
+		}
+		ticket = rest[match[4]:match[5]]
+		slug = before + " " + rest[match[5]:]
+	}
+	if branchType == "" {
+		return ""
+	}
+
+	separator := l.parser.Separator()
+
+	// The slug may still contain path components of the original name
+	title := strings.NewReplacer("/", " ", separator, " ").Replace(slug)
+	if strings.TrimSpace(title) == "" {
+		return FixRefName(branchType + "/" + strings.ToUpper(ticket))
+	}
+	return l.generator.GenerateNameWithConfig(BranchInfo{
+		Type:     branchType,
+		TicketID: strings.ToUpper(ticket),
+		Title:    title,
+	}, l.config.Generator)
+}
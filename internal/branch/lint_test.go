@@ -0,0 +1,89 @@
+package branch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestLinter() *Linter {
+	return NewLinter(LintConfig{
+		Parser:      ParserConfig{BranchTypes: []string{"feature", "hotfix", "feature-ui"}, Separator: "-"},
+		DefaultType: "feature",
+		Generator: GeneratorConfig{
+			MaxBranchLength: 40,
+			Separator:       "-",
+			Lowercase:       true,
+			Transliterator:  NewTransliterator("", nil, false),
+		},
+		Ignore: []string{"main", "release/*"},
+	})
+}
+
+func TestLinter_Lint(t *testing.T) {
+	linter := newTestLinter()
+
+	tests := []struct {
+		name       string
+		branch     string
+		rules      []string
+		suggestion string
+	}{
+		{"conforming", "feature/PROJ-1-add-login", nil, ""},
+		{"ticket only", "hotfix/PROJ-2", nil, ""},
+		{"longer type", "feature-ui/PROJ-3-button", nil, ""},
+		{"unknown type", "wip/PROJ-3-stuff", []string{LintRuleType}, "feature/PROJ-3-stuff"},
+		{"missing ticket", "hotfix/fix-it", []string{LintRuleTicket}, ""},
+		{"ticket not followed by separator", "feature/PROJ-4x", []string{LintRuleTemplate}, "feature/PROJ-4-x"},
+		{"unsanitized title", "feature/PROJ-5-Add_Login", []string{LintRuleSlug}, "feature/PROJ-5-add-login"},
+		{"too long", "feature/PROJ-6-a-very-long-title-that-does-not-fit", []string{LintRuleLength}, "feature/PROJ-6-a-very-long-title-that"},
+		{"ref rules", "feature/PROJ-7-x.lock", []string{string(RuleLockSuffix)}, "feature/PROJ-7-x"},
+		{"spaces", "fix PROJ-8 login", []string{LintRuleType, string(RuleSpace), string(RuleSpace)}, "feature/PROJ-8-fix-login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := linter.Lint(tt.branch)
+
+			var rules []string
+			for _, violation := range result.Violations {
+				rules = append(rules, violation.Rule)
+			}
+			if !reflect.DeepEqual(rules, tt.rules) {
+				t.Errorf("Lint(%q) rules = %v, want %v", tt.branch, rules, tt.rules)
+			}
+			if result.Valid != (tt.rules == nil) {
+				t.Errorf("Lint(%q) valid = %v", tt.branch, result.Valid)
+			}
+			if result.Suggestion != tt.suggestion {
+				t.Errorf("Lint(%q) suggestion = %q, want %q", tt.branch, result.Suggestion, tt.suggestion)
+			}
+			if result.Suggestion != "" {
+				if again := linter.Lint(result.Suggestion); !again.Valid {
+					t.Errorf("suggestion %q is not valid: %v", result.Suggestion, again.Violations)
+				}
+			}
+		})
+	}
+}
+
+func TestLinter_Ignore(t *testing.T) {
+	linter := newTestLinter()
+
+	for _, name := range []string{"main", "release/1.0"} {
+		result := linter.Lint(name)
+		if !result.Valid || !result.Ignored {
+			t.Errorf("Lint(%q) = %+v, want ignored", name, result)
+		}
+	}
+	if result := linter.Lint("release/1.0/hotfix"); result.Ignored {
+		t.Errorf("Lint() ignored %q, * should not match /", result.Branch)
+	}
+}
+
+func TestLinter_Positions(t *testing.T) {
+	result := newTestLinter().Lint("feature/PROJ-1-Fix")
+	expected := []LintViolation{{Rule: LintRuleSlug, Message: "title 'Fix' is not sanitized, expected 'fix'", Position: 15}}
+	if !reflect.DeepEqual(result.Violations, expected) {
+		t.Errorf("Lint() violations = %+v, want %+v", result.Violations, expected)
+	}
+}
@@ -0,0 +1,128 @@
+package branch
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ticketKeyPattern matches a Jira ticket key at the start of a string
+var ticketKeyPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9]*-[0-9]+)`)
+
+// ParserConfig holds configuration for branch name parsing
+type ParserConfig struct {
+	BranchTypes []string
+	Separator   string
+}
+
+// ParsedBranch represents the components recovered from a branch name
+type ParsedBranch struct {
+	Name     string
+	Type     string
+	TicketID string
+	Slug     string
+}
+
+// Parser interface defines branch name parsing operations
+type Parser interface {
+	Parse(name string) (ParsedBranch, error)
+}
+
+// BranchParser implements the Parser interface
+// It is the reverse of BranchGenerator.GenerateNameWithConfig
+type BranchParser struct {
+	config ParserConfig
+}
+
+// NewBranchParser creates a new BranchParser instance
+func NewBranchParser(config ParserConfig) *BranchParser {
+	// Match longer types first so that "feature-ui" wins over "feature"
+	types := make([]string, len(config.BranchTypes))
+	copy(types, config.BranchTypes)
+	sort.Slice(types, func(i, j int) bool {
+		if len(types[i]) != len(types[j]) {
+			return len(types[i]) > len(types[j])
+		}
+		return types[i] < types[j]
+	})
+	config.BranchTypes = types
+
+	if config.Separator == "" {
+		config.Separator = "-"
+	}
+
+	return &BranchParser{
+		config: config,
+	}
+}
+
+// Parse recovers branch type, ticket key and title slug from a branch name
+// Format: type/ticket-title
+func (p *BranchParser) Parse(name string) (ParsedBranch, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ParsedBranch{}, fmt.Errorf("branch name cannot be empty")
+	}
+
+	result := ParsedBranch{Name: name}
+
+	// 1. Recover the branch type prefix
+	branchType, rest, ok := p.MatchType(name)
+	if !ok {
+		return ParsedBranch{}, fmt.Errorf("branch '%s' does not start with a known branch type", name)
+	}
+	result.Type = branchType
+
+	// 2. Recover the ticket key
+	ticket := ticketKeyPattern.FindString(rest)
+	if ticket == "" {
+		return ParsedBranch{}, fmt.Errorf("branch '%s' does not contain a ticket key after '%s/'", name, result.Type)
+	}
+	result.TicketID = strings.ToUpper(ticket)
+	rest = rest[len(ticket):]
+
+	// 3. Whatever follows the separator is the sanitized title
+	if rest != "" {
+		if !strings.HasPrefix(rest, p.config.Separator) {
+			return ParsedBranch{}, fmt.Errorf("branch '%s' has unexpected characters after ticket key '%s'", name, ticket)
+		}
+		result.Slug = strings.TrimPrefix(rest, p.config.Separator)
+	}
+
+	return result, nil
+}
+
+// MatchType returns the branch type a name starts with and the part after its slash
+// Without configured types, everything before the first slash is the type
+func (p *BranchParser) MatchType(name string) (branchType, rest string, ok bool) {
+	if len(p.config.BranchTypes) == 0 {
+		branchType, rest, ok = strings.Cut(name, "/")
+		return branchType, rest, ok && branchType != ""
+	}
+	for _, candidate := range p.config.BranchTypes {
+		if strings.HasPrefix(name, candidate+"/") {
+			return candidate, strings.TrimPrefix(name, candidate+"/"), true
+		}
+	}
+	return "", "", false
+}
+
+// Separator returns the separator between ticket key and title
+func (p *BranchParser) Separator() string {
+	return p.config.Separator
+}
+
+// ParserConfigFromAppConfig creates a ParserConfig from application config
+func ParserConfigFromAppConfig(branchTypes map[string]string, separator string) ParserConfig {
+	types := make([]string, 0, len(branchTypes))
+	for key := range branchTypes {
+		types = append(types, key)
+	}
+	sort.Strings(types)
+
+	return ParserConfig{
+		BranchTypes: types,
+		Separator:   separator,
+	}
+}
@@ -0,0 +1,211 @@
+package branch
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBranchParser_Parse(t *testing.T) {
+	parser := NewBranchParser(ParserConfig{
+		BranchTypes: []string{"feature", "hotfix", "refactor", "support"},
+		Separator:   "-",
+	})
+
+	tests := []struct {
+		name        string
+		branch      string
+		wantType    string
+		wantTicket  string
+		wantSlug    string
+		expectError bool
+	}{
+		{
+			name:       "standard branch",
+			branch:     "feature/PROJ-123-add-user-authentication",
+			wantType:   "feature",
+			wantTicket: "PROJ-123",
+			wantSlug:   "add-user-authentication",
+		},
+		{
+			name:       "branch without title",
+			branch:     "hotfix/PROJ-456",
+			wantType:   "hotfix",
+			wantTicket: "PROJ-456",
+			wantSlug:   "",
+		},
+		{
+			name:       "lowercase ticket key is normalized",
+			branch:     "support/proj-7-docs",
+			wantType:   "support",
+			wantTicket: "PROJ-7",
+			wantSlug:   "docs",
+		},
+		{
+			name:       "ticket key with digits in project",
+			branch:     "refactor/AB2-99-cleanup",
+			wantType:   "refactor",
+			wantTicket: "AB2-99",
+			wantSlug:   "cleanup",
+		},
+		{
+			name:        "unknown branch type",
+			branch:      "bugfix/PROJ-1-fix",
+			expectError: true,
+		},
+		{
+			name:        "no ticket key",
+			branch:      "feature/add-login",
+			expectError: true,
+		},
+		{
+			name:        "missing separator after ticket",
+			branch:      "feature/PROJ-1_fix",
+			expectError: true,
+		},
+		{
+			name:        "plain branch",
+			branch:      "main",
+			expectError: true,
+		},
+		{
+			name:        "empty branch",
+			branch:      "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parser.Parse(tt.branch)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Parse(%q) expected error, got %+v", tt.branch, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.branch, err)
+			}
+			if result.Type != tt.wantType {
+				t.Errorf("Parse(%q).Type = %q, want %q", tt.branch, result.Type, tt.wantType)
+			}
+			if result.TicketID != tt.wantTicket {
+				t.Errorf("Parse(%q).TicketID = %q, want %q", tt.branch, result.TicketID, tt.wantTicket)
+			}
+			if result.Slug != tt.wantSlug {
+				t.Errorf("Parse(%q).Slug = %q, want %q", tt.branch, result.Slug, tt.wantSlug)
+			}
+			if result.Name != tt.branch {
+				t.Errorf("Parse(%q).Name = %q, want %q", tt.branch, result.Name, tt.branch)
+			}
+		})
+	}
+}
+
+func TestBranchParser_PrefersLongestType(t *testing.T) {
+	parser := NewBranchParser(ParserConfig{
+		BranchTypes: []string{"feature", "feature/ui"},
+		Separator:   "-",
+	})
+
+	result, err := parser.Parse("feature/ui/PROJ-1-button")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	if result.Type != "feature/ui" {
+		t.Errorf("Parse().Type = %q, want %q", result.Type, "feature/ui")
+	}
+}
+
+func TestBranchParser_NoConfiguredTypes(t *testing.T) {
+	parser := NewBranchParser(ParserConfig{})
+
+	result, err := parser.Parse("anything/PROJ-1-title")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+	if result.Type != "anything" || result.TicketID != "PROJ-1" || result.Slug != "title" {
+		t.Errorf("Parse() = %+v", result)
+	}
+}
+
+func TestBranchParser_RoundTrip(t *testing.T) {
+	generator := NewBranchGenerator(NewBranchSanitizer())
+
+	separators := []string{"-", "_", "."}
+	infos := []BranchInfo{
+		{Type: "feature", TicketID: "PROJ-123", Title: "Add user authentication"},
+		{Type: "hotfix", TicketID: "HOT-9", Title: "Fix: Login (issue) with \"quotes\""},
+		{Type: "refactor", TicketID: "REF-42", Title: strings.Repeat("very long title ", 10)},
+		{Type: "support", TicketID: "SUP-1", Title: ""},
+	}
+
+	for _, separator := range separators {
+		parser := NewBranchParser(ParserConfigFromAppConfig(map[string]string{
+			"feature":  "feature",
+			"hotfix":   "hotfix",
+			"refactor": "refactor",
+			"support":  "support",
+		}, separator))
+
+		for _, info := range infos {
+			name := generator.GenerateNameWithConfig(info, GeneratorConfigFromAppConfig(60, separator, true, false))
+
+			result, err := parser.Parse(name)
+			if err != nil {
+				t.Errorf("Parse(%q) unexpected error: %v", name, err)
+				continue
+			}
+			if result.Type != info.Type {
+				t.Errorf("Parse(%q).Type = %q, want %q", name, result.Type, info.Type)
+			}
+			if result.TicketID != info.TicketID {
+				t.Errorf("Parse(%q).TicketID = %q, want %q", name, result.TicketID, info.TicketID)
+			}
+			if want := strings.TrimPrefix(name, info.Type+"/"+info.TicketID+separator); result.Slug != want {
+				t.Errorf("Parse(%q).Slug = %q, want %q", name, result.Slug, want)
+			}
+		}
+	}
+}
+
+func TestBranchParser_MatchType(t *testing.T) {
+	parser := NewBranchParser(ParserConfig{BranchTypes: []string{"feature", "feature-ui"}})
+
+	tests := []struct {
+		name     string
+		wantType string
+		wantRest string
+		wantOK   bool
+	}{
+		{"feature-ui/PROJ-1-title", "feature-ui", "PROJ-1-title", true},
+		{"feature/PROJ-1", "feature", "PROJ-1", true},
+		{"bugfix/PROJ-1", "", "", false},
+		{"feature", "", "", false},
+	}
+
+	for _, tt := range tests {
+		branchType, rest, ok := parser.MatchType(tt.name)
+		if branchType != tt.wantType || rest != tt.wantRest || ok != tt.wantOK {
+			t.Errorf("MatchType(%q) = %q, %q, %v, want %q, %q, %v", tt.name, branchType, rest, ok, tt.wantType, tt.wantRest, tt.wantOK)
+		}
+	}
+
+	if got := parser.Separator(); got != "-" {
+		t.Errorf("Separator() = %q, want default %q", got, "-")
+	}
+}
+
+func TestParserConfigFromAppConfig(t *testing.T) {
+	config := ParserConfigFromAppConfig(map[string]string{
+		"support": "support/",
+		"feature": "feature/",
+	}, "_")
+
+	if len(config.BranchTypes) != 2 || config.BranchTypes[0] != "feature" || config.BranchTypes[1] != "support" {
+		t.Errorf("ParserConfigFromAppConfig().BranchTypes = %v, want sorted keys", config.BranchTypes)
+	}
+	if config.Separator != "_" {
+		t.Errorf("ParserConfigFromAppConfig().Separator = %q, want %q", config.Separator, "_")
+	}
+}
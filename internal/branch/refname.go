@@ -0,0 +1,183 @@
+package branch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// RefRule identifies a rule of git check-ref-format (with --branch) that a name can break
+type RefRule string
+
+const (
+	RuleEmpty            RefRule = "empty"             // the name is empty
+	RuleInvalidUTF8      RefRule = "invalid-utf8"      // the name is not valid UTF-8
+	RuleControlCharacter RefRule = "control-character" // ASCII control characters and DEL
+	RuleSpace            RefRule = "space"             // spaces
+	RuleSpecialCharacter RefRule = "special-character" // ~ ^ : ? * [ and backslash
+	RuleDoubleDot        RefRule = "double-dot"        // ".." anywhere
+	RuleAtBrace          RefRule = "at-brace"          // "@{" anywhere
+	RuleLoneAt           RefRule = "lone-at"           // the name is the single character "@"
+	RuleLeadingSlash     RefRule = "leading-slash"     // the name starts with "/"
+	RuleTrailingSlash    RefRule = "trailing-slash"    // the name ends with "/"
+	RuleDoubleSlash      RefRule = "double-slash"      // "//" anywhere
+	RuleLeadingDot       RefRule = "leading-dot"       // a component starts with "."
+	RuleLockSuffix       RefRule = "lock-suffix"       // a component ends with ".lock"
+	RuleTrailingDot      RefRule = "trailing-dot"      // the name ends with "."
+	RuleLeadingDash      RefRule = "leading-dash"      // the name starts with "-"
+	RuleHead             RefRule = "head"              // the name is "HEAD"
+)
+
+// RefViolation describes a broken rule and the byte position in the name where it occurs
+type RefViolation struct {
+	Rule     RefRule
+	Position int
+	Message  string
+}
+
+// String returns a human-readable description of the violation
+func (v RefViolation) String() string {
+	return fmt.Sprintf("%s at position %d", v.Message, v.Position)
+}
+
+// RefFormatError is returned by ValidateName for names Git does not accept
+type RefFormatError struct {
+	Name       string
+	Violations []RefViolation
+}
+
+// Error implements the error interface
+func (e *RefFormatError) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		descriptions[i] = violation.String()
+	}
+	return fmt.Sprintf("invalid branch name '%s': %s", e.Name, strings.Join(descriptions, "; "))
+}
+
+// CheckRefFormat checks a branch name against every rule of git check-ref-format --branch
+// It returns all violations in order of their position, or nil for a valid name
+func CheckRefFormat(name string) []RefViolation {
+	if name == "" {
+		return []RefViolation{{Rule: RuleEmpty, Position: 0, Message: "name is empty"}}
+	}
+
+	var violations []RefViolation
+	add := func(rule RefRule, position int, message string) {
+		violations = append(violations, RefViolation{Rule: rule, Position: position, Message: message})
+	}
+
+	switch name {
+	case "@":
+		add(RuleLoneAt, 0, "name cannot be '@'")
+	case "HEAD":
+		add(RuleHead, 0, "name cannot be 'HEAD'")
+	}
+	if strings.HasPrefix(name, "-") {
+		add(RuleLeadingDash, 0, "name cannot start with '-'")
+	}
+	if strings.HasPrefix(name, "/") {
+		add(RuleLeadingSlash, 0, "name cannot start with '/'")
+	}
+
+	componentStart := 0
+	for i := 0; i < len(name); {
+		r, size := utf8.DecodeRuneInString(name[i:])
+		next := byte(0)
+		if i+1 < len(name) {
+			next = name[i+1]
+		}
+
+		switch {
+		case r == utf8.RuneError && size == 1:
+			add(RuleInvalidUTF8, i, "contains invalid UTF-8")
+		case r < 0x20 || r == 0x7f:
+			add(RuleControlCharacter, i, fmt.Sprintf("contains control character %q", r))
+		case r == ' ':
+			add(RuleSpace, i, "contains a space")
+		case strings.ContainsRune("~^:?*[\\", r):
+			add(RuleSpecialCharacter, i, fmt.Sprintf("contains '%c'", r))
+		case r == '.' && next == '.':
+			add(RuleDoubleDot, i, "contains '..'")
+		case r == '@' && next == '{':
+			add(RuleAtBrace, i, "contains '@{'")
+		case r == '/' && next == '/':
+			add(RuleDoubleSlash, i, "contains '//'")
+		}
+		if r == '.' && i == componentStart {
+			add(RuleLeadingDot, i, "component starts with '.'")
+		}
+
+		if r == '/' || i+size == len(name) {
+			end := i
+			if r != '/' {
+				end = i + size
+			}
+			if strings.HasSuffix(name[componentStart:end], ".lock") {
+				add(RuleLockSuffix, end-len(".lock"), "component ends with '.lock'")
+			}
+			componentStart = i + size
+		}
+		i += size
+	}
+
+	if strings.HasSuffix(name, "/") {
+		add(RuleTrailingSlash, len(name)-1, "name cannot end with '/'")
+	}
+	if strings.HasSuffix(name, ".") {
+		add(RuleTrailingDot, len(name)-1, "name cannot end with '.'")
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Position < violations[j].Position
+	})
+	return violations
+}
+
+// FixRefName repairs a branch name to the nearest name Git accepts:
+// forbidden characters become "-", ".." and "//" are collapsed, empty components are
+// dropped and leading dots, ".lock" suffixes and trailing dots are removed
+// It returns an empty string if nothing usable is left
+func FixRefName(name string) string {
+	result := strings.ToValidUTF8(name, "")
+
+	result = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == ' ' || strings.ContainsRune("~^:?*[\\", r) {
+			return '-'
+		}
+		return r
+	}, result)
+	for strings.Contains(result, "@{") {
+		result = strings.ReplaceAll(result, "@{", "@")
+	}
+
+	// Repeat until stable: removing a suffix can expose another one, e.g. "a.lock.lock"
+	for previous := ""; previous != result; {
+		previous = result
+		for strings.Contains(result, "..") {
+			result = strings.ReplaceAll(result, "..", ".")
+		}
+
+		var components []string
+		for _, component := range strings.Split(result, "/") {
+			component = strings.TrimLeft(component, ".")
+			for strings.HasSuffix(component, ".lock") {
+				component = strings.TrimSuffix(component, ".lock")
+			}
+			if component != "" {
+				components = append(components, component)
+			}
+		}
+		result = strings.Join(components, "/")
+		result = strings.TrimLeft(strings.TrimRight(result, "."), "-")
+	}
+
+	switch result {
+	case "@":
+		return "at"
+	case "HEAD":
+		return "head"
+	}
+	return result
+}
@@ -0,0 +1,171 @@
+package branch
+
+import (
+	"errors"
+	"os/exec"
+	"reflect"
+	"testing"
+)
+
+// refNameCorpus holds names with the rules they break, in order of position
+var refNameCorpus = []struct {
+	name  string
+	rules []RefRule
+}{
+	{"feature/PROJ-123-add-login", nil},
+	{"release/v1.2.3", nil},
+	{"feature/ПРОЕКТ-1-修复", nil},
+	{"a@b", nil},
+	{"main.locked", nil},
+	{"", []RefRule{RuleEmpty}},
+	{"@", []RefRule{RuleLoneAt}},
+	{"HEAD", []RefRule{RuleHead}},
+	{"-feature", []RefRule{RuleLeadingDash}},
+	{"/feature", []RefRule{RuleLeadingSlash}},
+	{"feature/", []RefRule{RuleTrailingSlash}},
+	{"feature//x", []RefRule{RuleDoubleSlash}},
+	{"feature/x.", []RefRule{RuleTrailingDot}},
+	{"feature/a..b", []RefRule{RuleDoubleDot}},
+	{"feature/a@{1}", []RefRule{RuleAtBrace}},
+	{".feature", []RefRule{RuleLeadingDot}},
+	{"feature/.hidden", []RefRule{RuleLeadingDot}},
+	{"feature.lock", []RefRule{RuleLockSuffix}},
+	{"feature.lock/x", []RefRule{RuleLockSuffix}},
+	{"feature/a b", []RefRule{RuleSpace}},
+	{"feature/a\tb", []RefRule{RuleControlCharacter}},
+	{"feature/a\x7fb", []RefRule{RuleControlCharacter}},
+	{"feature/a\\b", []RefRule{RuleSpecialCharacter}},
+	{"feature/a~b^c:d", []RefRule{RuleSpecialCharacter, RuleSpecialCharacter, RuleSpecialCharacter}},
+	{"feature/a?b*c[d", []RefRule{RuleSpecialCharacter, RuleSpecialCharacter, RuleSpecialCharacter}},
+	{"feature/a\xffb", []RefRule{RuleInvalidUTF8}},
+	{"..lock", []RefRule{RuleDoubleDot, RuleLeadingDot, RuleLockSuffix}},
+	{"-/x/", []RefRule{RuleLeadingDash, RuleTrailingSlash}},
+}
+
+func rulesOf(violations []RefViolation) []RefRule {
+	var rules []RefRule
+	for _, violation := range violations {
+		rules = append(rules, violation.Rule)
+	}
+	return rules
+}
+
+func TestCheckRefFormat(t *testing.T) {
+	for _, tt := range refNameCorpus {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rulesOf(CheckRefFormat(tt.name)); !reflect.DeepEqual(got, tt.rules) {
+				t.Errorf("CheckRefFormat(%q) = %v, want %v", tt.name, got, tt.rules)
+			}
+		})
+	}
+}
+
+// TestCheckRefFormat_MatchesGit compares the corpus with git check-ref-format --branch
+func TestCheckRefFormat_MatchesGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	for _, tt := range refNameCorpus {
+		// git cannot receive an empty argument, accepts arbitrary bytes and expands "@" to HEAD
+		if tt.name == "" || tt.name == "@" || tt.rules != nil && tt.rules[0] == RuleInvalidUTF8 {
+			continue
+		}
+		gitAccepts := exec.Command("git", "check-ref-format", "--branch", tt.name).Run() == nil
+		if valid := tt.rules == nil; valid != gitAccepts {
+			t.Errorf("%q: CheckRefFormat valid = %v, git check-ref-format --branch valid = %v", tt.name, valid, gitAccepts)
+		}
+	}
+}
+
+func TestCheckRefFormat_Positions(t *testing.T) {
+	violations := CheckRefFormat("fix/a b..c.lock")
+	expected := []RefViolation{
+		{Rule: RuleSpace, Position: 5, Message: "contains a space"},
+		{Rule: RuleDoubleDot, Position: 7, Message: "contains '..'"},
+		{Rule: RuleLockSuffix, Position: 10, Message: "component ends with '.lock'"},
+	}
+	if !reflect.DeepEqual(violations, expected) {
+		t.Errorf("CheckRefFormat() = %v, want %v", violations, expected)
+	}
+}
+
+func TestBranchGenerator_ValidateName_RefFormatError(t *testing.T) {
+	generator := NewBranchGenerator(NewBranchSanitizer())
+
+	err := generator.ValidateName("feature/x.lock")
+	var refErr *RefFormatError
+	if !errors.As(err, &refErr) {
+		t.Fatalf("ValidateName() error = %v, want *RefFormatError", err)
+	}
+	if len(refErr.Violations) != 1 || refErr.Violations[0].Rule != RuleLockSuffix {
+		t.Errorf("Violations = %v, want one lock-suffix violation", refErr.Violations)
+	}
+	if err.Error() != "invalid branch name 'feature/x.lock': component ends with '.lock' at position 9" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
+
+func TestFixRefName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"feature/PROJ-1-ok", "feature/PROJ-1-ok"},
+		{"feature/PROJ 1 title", "feature/PROJ-1-title"},
+		{"feature/a~b^c:d?e*f[g\\h", "feature/a-b-c-d-e-f-g-h"},
+		{"feature/a..b...c", "feature/a.b.c"},
+		{"feature/a@{1}", "feature/a@1}"},
+		{"/feature//x/", "feature/x"},
+		{".feature/.hidden", "feature/hidden"},
+		{"feature.lock/x.lock.lock", "feature/x"},
+		{"feature/x.", "feature/x"},
+		{"feature/x.lock.", "feature/x"},
+		{"--feature", "feature"},
+		{"-/.x", "x"},
+		{"feature/a\x00b\xff", "feature/a-b"},
+		{"@", "at"},
+		{"HEAD", "head"},
+		{"./..lock", "lock"},
+		{"/./../", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := FixRefName(tt.input)
+			if got != tt.expected {
+				t.Errorf("FixRefName(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+			if got != "" && CheckRefFormat(got) != nil {
+				t.Errorf("FixRefName(%q) = %q is still invalid: %v", tt.input, got, CheckRefFormat(got))
+			}
+		})
+	}
+}
+
+func TestBranchGenerator_GenerateNameFixesTicketID(t *testing.T) {
+	generator := NewBranchGenerator(NewBranchSanitizer())
+	info := BranchInfo{Type: "feature", TicketID: "PROJ 1", Title: "Add login"}
+
+	name := generator.GenerateName(info)
+	if name != "feature/PROJ-1-add-login" {
+		t.Errorf("GenerateName() = %q, want %q", name, "feature/PROJ-1-add-login")
+	}
+}
+
+// FuzzFixRefName checks that repaired names are empty or pass CheckRefFormat
+func FuzzFixRefName(f *testing.F) {
+	for _, tt := range refNameCorpus {
+		f.Add(tt.name)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		fixed := FixRefName(name)
+		if fixed != "" && CheckRefFormat(fixed) != nil {
+			t.Fatalf("FixRefName(%q) = %q: %v", name, fixed, CheckRefFormat(fixed))
+		}
+		if CheckRefFormat(name) == nil && fixed != name {
+			t.Fatalf("FixRefName(%q) = %q changed a valid name", name, fixed)
+		}
+	})
+}
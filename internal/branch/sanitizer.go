@@ -11,6 +11,13 @@ type SanitizationOptions struct {
 	Lowercase     bool
 	RemoveUmlauts bool
 	MaxLength     int
+
+	// Transliterator converts letters of other scripts to ASCII; nil falls back to
+	// RemoveUmlauts and removes every other non-ASCII letter
+	Transliterator *Transliterator
+
+	// LengthUnit selects whether MaxLength counts bytes or characters
+	LengthUnit LengthUnit
 }
 
 // Sanitizer interface defines branch name sanitization operations
@@ -28,7 +35,7 @@ func NewBranchSanitizer() *BranchSanitizer {
 
 // Sanitize sanitizes a string according to the provided options
 // Implements comprehensive sanitization logic:
-// 1. Handle German umlauts (if enabled)
+// 1. Transliterate letters of other scripts, or handle German umlauts (if enabled)
 // 2. Remove quotes, parentheses, colons, and other problematic characters
 // 3. Replace " - " (space-hyphen-space) with just the separator
 // 4. Replace remaining spaces with the configured separator
@@ -44,8 +51,10 @@ func (s *BranchSanitizer) Sanitize(input string, options SanitizationOptions) st
 
 	result := strings.TrimSpace(input)
 
-	// 1. Handle German umlauts first if requested (before other character removal)
-	if options.RemoveUmlauts {
+	// 1. Transliterate or handle German umlauts first if requested (before other character removal)
+	if options.Transliterator != nil {
+		result = options.Transliterator.Transliterate(result)
+	} else if options.RemoveUmlauts {
 		result = s.removeUmlauts(result)
 	}
 
@@ -77,6 +86,9 @@ func (s *BranchSanitizer) Sanitize(input string, options SanitizationOptions) st
 	// 6. Remove special characters that might cause Git issues
 	// Keep only alphanumeric characters, the separator, and dots (for version numbers)
 	allowedChars := `a-zA-Z0-9` + regexp.QuoteMeta(separator) + `\.`
+	if options.Transliterator != nil && options.Transliterator.KeepUnicode() {
+		allowedChars = `\p{L}\p{M}\p{N}` + allowedChars
+	}
 	result = regexp.MustCompile(`[^`+allowedChars+`]`).ReplaceAllString(result, "")
 
 	// 7. Apply case conversion if requested
@@ -85,19 +97,19 @@ func (s *BranchSanitizer) Sanitize(input string, options SanitizationOptions) st
 	}
 
 	// 8. Trim to specified length (ensuring we don't break in the middle of a word if possible)
-	if options.MaxLength > 0 && len(result) > options.MaxLength {
-		result = result[:options.MaxLength]
+	// Lengths are counted in options.LengthUnit and characters are never split
+	if options.MaxLength > 0 && options.LengthUnit.Len(result) > options.MaxLength {
+		result = options.LengthUnit.Truncate(result, options.MaxLength)
 		// Try to break at a separator to avoid cutting words
-		if lastSep := strings.LastIndex(result, separator); lastSep > options.MaxLength/2 {
+		if lastSep := strings.LastIndex(result, separator); lastSep >= 0 && options.LengthUnit.Len(result[:lastSep]) > options.MaxLength/2 {
 			result = result[:lastSep]
 		}
 	}
 
 	// 9. Clean up leading and trailing separators
-	result = strings.Trim(result, separator)
-
-	// Final cleanup: ensure no leading dots (Git doesn't allow branches starting with dots)
-	result = strings.TrimLeft(result, ".")
+	// Git doesn't allow consecutive dots or names starting or ending with dots either
+	result = regexp.MustCompile(`\.{2,}`).ReplaceAllString(result, ".")
+	result = strings.Trim(result, separator+".")
 
 	return result
 }
@@ -0,0 +1,173 @@
+package branch
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ShorteningOptions configures how titles that do not fit the branch name are shortened
+type ShorteningOptions struct {
+	RemovePrefixes bool
+	StopWords      []string
+	Abbreviations  map[string]string
+}
+
+// builtinStopWords are low-information words dropped first when a title is too long
+// Negations are deliberately missing: dropping them would invert the meaning of a title
+var builtinStopWords = map[string][]string{
+	"en": {
+		"a", "an", "the", "and", "or", "of", "to", "in", "on", "at", "by", "for", "from", "with",
+		"into", "onto", "as", "is", "are", "be", "been", "it", "its", "this", "that", "these",
+		"those", "should", "would", "could", "can", "will", "when", "while", "some", "all",
+	},
+	"de": {
+		"der", "die", "das", "den", "dem", "des", "ein", "eine", "einen", "einem", "einer",
+		"eines", "und", "oder", "für", "mit", "von", "vom", "zu", "zum", "zur", "im", "in",
+		"am", "an", "auf", "aus", "bei", "ist", "sind", "wird", "werden", "soll", "sollte",
+		"beim", "als", "dass", "wenn",
+	},
+}
+
+// titlePrefixPattern matches one leading tag such as "[BE]", "(UI)", "FE:" or "PROJ-123:"
+var titlePrefixPattern = regexp.MustCompile(`^\s*(\[[^\]]{0,20}\]|\([^)]{0,20}\)|\{[^}]{0,20}\}|[A-Z0-9][A-Z0-9_-]{0,11}:)\s*`)
+
+// BuiltinStopWordLanguages returns the languages with built-in stop word lists
+func BuiltinStopWordLanguages() []string {
+	languages := make([]string, 0, len(builtinStopWords))
+	for language := range builtinStopWords {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// StopWordsFor returns the built-in stop words of the given languages plus the custom ones
+// Custom lists are keyed by language; a language may exist only in the custom lists
+func StopWordsFor(languages []string, custom map[string][]string) []string {
+	var words []string
+	for _, language := range languages {
+		words = append(words, builtinStopWords[language]...)
+		words = append(words, custom[language]...)
+	}
+	return words
+}
+
+// RemoveTitlePrefixes strips leading tags like "[BE]", "(UI)" or "FE:" from a title
+// Tags are kept if nothing else is left
+func RemoveTitlePrefixes(title string) string {
+	result := title
+	for {
+		stripped := titlePrefixPattern.ReplaceAllString(result, "")
+		if stripped == result {
+			break
+		}
+		result = stripped
+	}
+
+	if strings.TrimSpace(result) == "" {
+		return title
+	}
+	return result
+}
+
+// titleWord is a word of a title with its sanitized form and the form used for dictionary lookups
+type titleWord struct {
+	slug   string
+	lookup string
+}
+
+// ShortenTitle sanitizes a title so that it fits maxLength, shortening it in stages:
+// 1. Remove leading tags like "[BE]" (if enabled)
+// 2. If the title does not fit, replace words from the abbreviation dictionary
+// 3. Drop stop words, last ones first
+// 4. Drop trailing words
+// 5. Cut the remaining title with the sanitizer
+// At least one word is always kept. options.MaxLength is ignored in favor of maxLength.
+func ShortenTitle(title string, sanitizer Sanitizer, options SanitizationOptions, shortening ShorteningOptions, maxLength int) string {
+	if shortening.RemovePrefixes {
+		title = RemoveTitlePrefixes(title)
+	}
+
+	options.MaxLength = 0
+	if full := sanitizer.Sanitize(title, options); maxLength <= 0 || options.LengthUnit.Len(full) <= maxLength {
+		return full
+	}
+
+	separator := options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	var words []titleWord
+	for _, field := range strings.Fields(title) {
+		slug := sanitizer.Sanitize(field, options)
+		if slug == "" {
+			continue
+		}
+		lookup := strings.ToLower(strings.TrimFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}))
+		words = append(words, titleWord{slug: slug, lookup: lookup})
+	}
+
+	// Abbreviate words from the dictionary, matching case-insensitively
+	abbreviations := make(map[string]string, len(shortening.Abbreviations))
+	for word, abbreviation := range shortening.Abbreviations {
+		abbreviations[strings.ToLower(word)] = abbreviation
+	}
+	for i, word := range words {
+		if abbreviation, ok := abbreviations[word.lookup]; ok {
+			words[i].slug = sanitizer.Sanitize(abbreviation, options)
+		}
+	}
+	words = dropEmptyWords(words)
+
+	// Drop stop words from the end of the title towards the start
+	stopWords := make(map[string]bool, len(shortening.StopWords))
+	for _, word := range shortening.StopWords {
+		stopWords[strings.ToLower(word)] = true
+	}
+	for i := len(words) - 1; i >= 0 && joinedLength(words, separator, options.LengthUnit) > maxLength && len(words) > 1; i-- {
+		if stopWords[words[i].lookup] {
+			words = append(words[:i], words[i+1:]...)
+		}
+	}
+
+	// Drop trailing words
+	for len(words) > 1 && joinedLength(words, separator, options.LengthUnit) > maxLength {
+		words = words[:len(words)-1]
+	}
+
+	slugs := make([]string, len(words))
+	for i, word := range words {
+		slugs[i] = word.slug
+	}
+
+	options.MaxLength = maxLength
+	return sanitizer.Sanitize(strings.Join(slugs, separator), options)
+}
+
+// dropEmptyWords removes words whose sanitized form is empty
+func dropEmptyWords(words []titleWord) []titleWord {
+	result := words[:0]
+	for _, word := range words {
+		if word.slug != "" {
+			result = append(result, word)
+		}
+	}
+	return result
+}
+
+// joinedLength returns the length of the words joined by the separator, counted in unit
+func joinedLength(words []titleWord, separator string, unit LengthUnit) int {
+	if len(words) == 0 {
+		return 0
+	}
+	length := unit.Len(separator) * (len(words) - 1)
+	for _, word := range words {
+		length += unit.Len(word.slug)
+	}
+	return length
+}
@@ -0,0 +1,129 @@
+package branch
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveTitlePrefixes(t *testing.T) {
+	tests := []struct {
+		title    string
+		expected string
+	}{
+		{"[BE] Fix login", "Fix login"},
+		{"[BE][API] Fix login", "Fix login"},
+		{"FE: Update header", "Update header"},
+		{"PROJ-123: Update header", "Update header"},
+		{"(UI) [WIP] Redesign", "Redesign"},
+		{"Login: fix redirect", "Login: fix redirect"},
+		{"Fix [BE] handling", "Fix [BE] handling"},
+		{"[BE]", "[BE]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := RemoveTitlePrefixes(tt.title); got != tt.expected {
+				t.Errorf("RemoveTitlePrefixes(%q) = %q, want %q", tt.title, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestShortenTitle(t *testing.T) {
+	sanitizer := NewBranchSanitizer()
+	options := SanitizationOptions{Separator: "-", Lowercase: true, RemoveUmlauts: true}
+	shortening := ShorteningOptions{
+		RemovePrefixes: true,
+		StopWords:      StopWordsFor([]string{"en", "de"}, nil),
+		Abbreviations:  map[string]string{"Authentication": "auth", "configuration": "config"},
+	}
+
+	tests := []struct {
+		name      string
+		title     string
+		maxLength int
+		expected  string
+	}{
+		{
+			name:      "fits unchanged apart from prefix",
+			title:     "[BE] Add the user authentication",
+			maxLength: 40,
+			expected:  "add-the-user-authentication",
+		},
+		{
+			name:      "abbreviations first",
+			title:     "Add user authentication",
+			maxLength: 20,
+			expected:  "add-user-auth",
+		},
+		{
+			name:      "stop words dropped from the end",
+			title:     "Add the option to the configuration of an app",
+			maxLength: 30,
+			expected:  "add-the-option-to-config-app",
+		},
+		{
+			name:      "trailing words dropped after stop words",
+			title:     "Fix the crash in the payment service when retrying refunds",
+			maxLength: 30,
+			expected:  "fix-crash-payment-service",
+		},
+		{
+			name:      "german stop words and umlauts",
+			title:     "Fehler für die Übersicht der Bestellungen beheben",
+			maxLength: 40,
+			expected:  "fehler-uebersicht-bestellungen-beheben",
+		},
+		{
+			name:      "single long word is cut",
+			title:     "Supercalifragilisticexpialidocious",
+			maxLength: 10,
+			expected:  "supercalif",
+		},
+		{
+			name:      "punctuation does not defeat dictionary lookups",
+			title:     "Refactor (the) authentication, and configuration",
+			maxLength: 22,
+			expected:  "refactor-auth-config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ShortenTitle(tt.title, sanitizer, options, shortening, tt.maxLength)
+			if got != tt.expected {
+				t.Errorf("ShortenTitle() = %q, want %q", got, tt.expected)
+			}
+			if len(got) > tt.maxLength {
+				t.Errorf("ShortenTitle() length = %d, want at most %d", len(got), tt.maxLength)
+			}
+		})
+	}
+}
+
+func TestStopWordsFor(t *testing.T) {
+	words := StopWordsFor([]string{"team"}, map[string][]string{"team": {"pls"}, "other": {"x"}})
+	if !reflect.DeepEqual(words, []string{"pls"}) {
+		t.Errorf("StopWordsFor() = %v, want custom words of the selected language only", words)
+	}
+
+	if got := BuiltinStopWordLanguages(); !reflect.DeepEqual(got, []string{"de", "en"}) {
+		t.Errorf("BuiltinStopWordLanguages() = %v", got)
+	}
+}
+
+func TestBranchGenerator_GenerateNameWithShortening(t *testing.T) {
+	generator := NewBranchGenerator(NewBranchSanitizer())
+	config := GeneratorConfigFromAppConfig(41, "-", true, false)
+	config.Shortening = ShorteningOptionsFromAppConfig(true, []string{"en"}, nil, map[string]string{"authentication": "auth"})
+
+	name := generator.GenerateNameWithConfig(BranchInfo{
+		Type:     "feature",
+		TicketID: "PROJ-123",
+		Title:    "[BE] Add the authentication for the admin dashboard",
+	}, config)
+
+	if name != "feature/PROJ-123-add-auth-admin-dashboard" {
+		t.Errorf("GenerateNameWithConfig() = %q", name)
+	}
+}
@@ -0,0 +1,213 @@
+package branch
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TransliterationTable maps characters to their ASCII replacements
+type TransliterationTable map[rune]string
+
+// Transliterator converts letters of other scripts to ASCII before a title is sanitized
+// Tables are consulted in order; the first table containing a character wins
+type Transliterator struct {
+	tables      []TransliterationTable
+	keepUnicode bool
+}
+
+// scriptTables are the generic tables used for every language
+var scriptTables = map[string]TransliterationTable{
+	"latin": withUppercase(TransliterationTable{
+		// Latin-1 Supplement
+		'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+		'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i",
+		'î': "i", 'ï': "i", 'ð': "d", 'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o",
+		'õ': "o", 'ö': "o", 'ø': "o", 'ù': "u", 'ú': "u", 'û': "u", 'ü': "u",
+		'ý': "y", 'ÿ': "y", 'þ': "th", 'ß': "ss",
+		// Latin Extended-A
+		'ā': "a", 'ă': "a", 'ą': "a", 'ć': "c", 'ĉ': "c", 'ċ': "c", 'č': "c",
+		'ď': "d", 'đ': "d", 'ē': "e", 'ĕ': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+		'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g", 'ĥ': "h", 'ħ': "h", 'ĩ': "i",
+		'ī': "i", 'ĭ': "i", 'į': "i", 'ı': "i", 'İ': "I", 'ĳ': "ij", 'ĵ': "j",
+		'ķ': "k", 'ĸ': "k", 'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ŀ': "l", 'ł': "l",
+		'ń': "n", 'ņ': "n", 'ň': "n", 'ŉ': "n", 'ŋ': "ng", 'ō': "o", 'ŏ': "o",
+		'ő': "o", 'œ': "oe", 'ŕ': "r", 'ŗ': "r", 'ř': "r", 'ś': "s", 'ŝ': "s",
+		'ş': "s", 'š': "s", 'ţ': "t", 'ť': "t", 'ŧ': "t", 'ũ': "u", 'ū': "u",
+		'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u", 'ŵ': "w", 'ŷ': "y", 'ź': "z",
+		'ż': "z", 'ž': "z", 'ſ': "s",
+		// Latin Extended-B (Romanian, Vietnamese, Croatian digraphs)
+		'ș': "s", 'ț': "t", 'ơ': "o", 'ư': "u", 'ƒ': "f", 'ǆ': "dz", 'ǉ': "lj",
+		'ǌ': "nj", 'ǎ': "a", 'ǐ': "i", 'ǒ': "o", 'ǔ': "u", 'ə': "e",
+	}),
+	"cyrillic": withUppercase(TransliterationTable{
+		// Russian
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+		'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+		'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+		'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+		'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+		// Ukrainian and Belarusian
+		'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u",
+		// Serbian and Macedonian
+		'ђ': "dj", 'ј': "j", 'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz",
+		'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+	}),
+	"greek": withUppercase(TransliterationTable{
+		'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+		'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+		'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+		'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+		'ά': "a", 'έ': "e", 'ή': "i", 'ί': "i", 'ό': "o", 'ύ': "y", 'ώ': "o",
+		'ϊ': "i", 'ϋ': "y", 'ΐ': "i", 'ΰ': "y",
+	}),
+}
+
+// scriptOrder is the order in which the script tables are consulted
+var scriptOrder = []string{"latin", "cyrillic", "greek"}
+
+// languageTables hold language-specific rules consulted before the script tables
+var languageTables = map[string]TransliterationTable{
+	"de": withUppercase(TransliterationTable{'ä': "ae", 'ö': "oe", 'ü': "ue"}),
+	"da": withUppercase(TransliterationTable{'æ': "ae", 'ø': "oe", 'å': "aa"}),
+	"no": withUppercase(TransliterationTable{'æ': "ae", 'ø': "oe", 'å': "aa"}),
+	"sv": withUppercase(TransliterationTable{'ä': "a", 'ö': "o", 'å': "a"}),
+	"fi": withUppercase(TransliterationTable{'ä': "a", 'ö': "o", 'å': "a"}),
+	"uk": withUppercase(TransliterationTable{'г': "h", 'и': "y", 'й': "i"}),
+}
+
+func init() {
+	// Vietnamese letters with tone marks (U+1EA0-U+1EF9) come in upper/lower pairs
+	// grouped by their base letter
+	bases := []struct {
+		letter string
+		pairs  int
+	}{{"a", 12}, {"e", 8}, {"i", 2}, {"o", 12}, {"u", 7}, {"y", 4}}
+	r := rune(0x1EA0)
+	for _, base := range bases {
+		for i := 0; i < base.pairs; i++ {
+			scriptTables["latin"][r] = strings.ToUpper(base.letter)
+			scriptTables["latin"][r+1] = base.letter
+			r += 2
+		}
+	}
+}
+
+// withUppercase adds the uppercase forms of the lowercase letters of a table
+// Multi-letter replacements are capitalized (Ж → Zh, Æ → Ae)
+func withUppercase(table TransliterationTable) TransliterationTable {
+	for letter, replacement := range table {
+		upper := unicode.ToUpper(letter)
+		if upper == letter || upper < utf8.RuneSelf {
+			continue
+		}
+		if _, exists := table[upper]; exists {
+			continue
+		}
+		if replacement != "" {
+			replacement = strings.ToUpper(replacement[:1]) + replacement[1:]
+		}
+		table[upper] = replacement
+	}
+	return table
+}
+
+// TransliterationLanguages returns the languages with built-in transliteration rules
+func TransliterationLanguages() []string {
+	languages := make([]string, 0, len(languageTables))
+	for language := range languageTables {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// NewTransliterator creates a Transliterator using the rules of the given language
+// (empty for generic rules) and custom replacements keyed by single characters
+// Letters without a replacement are removed by the sanitizer unless keepUnicode is set
+func NewTransliterator(language string, custom map[string]string, keepUnicode bool) *Transliterator {
+	t := &Transliterator{keepUnicode: keepUnicode}
+
+	if len(custom) > 0 {
+		table := make(TransliterationTable, len(custom))
+		for character, replacement := range custom {
+			if r, size := utf8.DecodeRuneInString(character); size > 0 && size == len(character) {
+				table[r] = replacement
+			}
+		}
+		t.tables = append(t.tables, table)
+	}
+	if table, ok := languageTables[language]; ok {
+		t.tables = append(t.tables, table)
+	}
+	for _, script := range scriptOrder {
+		t.tables = append(t.tables, scriptTables[script])
+	}
+
+	return t
+}
+
+// TransliteratorFromAppConfig creates a Transliterator for GeneratorConfig from application config
+// It returns nil if transliteration is disabled; German rules apply by default if removeUmlauts is set
+func TransliteratorFromAppConfig(enabled bool, language string, removeUmlauts bool, custom map[string]string, keepUnicode bool) *Transliterator {
+	if !enabled {
+		return nil
+	}
+	if language == "" && removeUmlauts {
+		language = "de"
+	}
+	return NewTransliterator(language, custom, keepUnicode)
+}
+
+// KeepUnicode reports whether letters without a replacement are kept in branch names
+func (t *Transliterator) KeepUnicode() bool {
+	return t.keepUnicode
+}
+
+// Transliterate replaces every character found in the tables and falls back to:
+// - fullwidth ASCII forms (Ａ, １) are mapped to ASCII
+// - combining marks following an ASCII letter (decomposed accents) are dropped
+// - any other character is kept as is
+func (t *Transliterator) Transliterate(input string) string {
+	var builder strings.Builder
+	builder.Grow(len(input))
+
+	previousASCII := false
+	for _, r := range input {
+		if r < utf8.RuneSelf {
+			builder.WriteRune(r)
+			previousASCII = true
+			continue
+		}
+
+		if replacement, ok := t.lookup(r); ok {
+			builder.WriteString(replacement)
+			previousASCII = true
+			continue
+		}
+
+		switch {
+		case r >= 0xFF01 && r <= 0xFF5E:
+			builder.WriteRune(r - 0xFEE0)
+			previousASCII = true
+		case unicode.Is(unicode.Mn, r) && previousASCII:
+			// Drop the accent, keep previousASCII for stacked marks
+		default:
+			builder.WriteRune(r)
+			previousASCII = false
+		}
+	}
+
+	return builder.String()
+}
+
+// lookup returns the replacement of a character from the first table containing it
+func (t *Transliterator) lookup(r rune) (string, bool) {
+	for _, table := range t.tables {
+		if replacement, ok := table[r]; ok {
+			return replacement, true
+		}
+	}
+	return "", false
+}
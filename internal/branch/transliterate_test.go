@@ -0,0 +1,148 @@
+package branch
+
+import "testing"
+
+// TestTransliterator_Corpus checks sanitized titles from every supported script
+func TestTransliterator_Corpus(t *testing.T) {
+	sanitizer := NewBranchSanitizer()
+
+	corpus := map[string][]struct {
+		input    string
+		expected string
+	}{
+		"latin": {
+			{"Café résumé naïve piñata", "cafe-resume-naive-pinata"},
+			{"Łódź źródło żółć", "lodz-zrodlo-zolc"},
+			{"Příliš žluťoučký kůň", "prilis-zlutoucky-kun"},
+			{"Işık şoför ğüzel İstanbul", "isik-sofor-guzel-istanbul"},
+			{"Ærø Øresund Ålborg", "aero-oresund-alborg"},
+			{"Țară și știință", "tara-si-stiinta"},
+			{"Tiếng Việt được", "tieng-viet-duoc"},
+			{"Straße Œuvre ĳssel", "strasse-oeuvre-ijssel"},
+		},
+		"cyrillic": {
+			{"Исправить ошибку авторизации", "ispravit-oshibku-avtorizatsii"},
+			{"Щука и ёжик", "shchuka-i-yozhik"},
+			{"Додати підтримку її", "dodati-pidtrimku-yiyi"},
+			{"Љубљана Ђорђе Џеп", "ljubljana-djordje-dzep"},
+		},
+		"greek": {
+			{"Διόρθωση σφάλματος", "diorthosi-sfalmatos"},
+			{"Ψυχή ΘΕΜΑ", "psychi-thema"},
+		},
+		"fallback": {
+			{"Ｆｕｌｌｗｉｄｔｈ １２３", "fullwidth-123"},
+			{"Cafe\u0301 decomposed", "cafe-decomposed"},
+			{"修复 login bug", "login-bug"},
+		},
+	}
+
+	transliterator := NewTransliterator("", nil, false)
+	for script, cases := range corpus {
+		for _, tc := range cases {
+			t.Run(script+"/"+tc.input, func(t *testing.T) {
+				result := sanitizer.Sanitize(tc.input, SanitizationOptions{
+					Separator:      "-",
+					Lowercase:      true,
+					Transliterator: transliterator,
+				})
+				if result != tc.expected {
+					t.Errorf("Sanitize(%q) = %q, want %q", tc.input, result, tc.expected)
+				}
+			})
+		}
+	}
+}
+
+// TestTransliterator_Languages tests language-specific rules overriding the generic ones
+func TestTransliterator_Languages(t *testing.T) {
+	tests := []struct {
+		language string
+		input    string
+		expected string
+	}{
+		{"", "Mädchen Ødegård", "Madchen Odegard"},
+		{"de", "Mädchen Größe Über", "Maedchen Groesse Ueber"},
+		{"sv", "Mädchen Ödegård", "Madchen Odegard"},
+		{"fi", "Hyvä päivä", "Hyva paiva"},
+		{"da", "Ødegård Æble", "Oedegaard Aeble"},
+		{"no", "Blåbær", "Blaabaer"},
+		{"uk", "Григорій Київ", "Hryhorii Kyyiv"},
+		{"unknown", "Größe", "Grosse"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language+"/"+tt.input, func(t *testing.T) {
+			result := NewTransliterator(tt.language, nil, false).Transliterate(tt.input)
+			if result != tt.expected {
+				t.Errorf("Transliterate(%q) with %q = %q, want %q", tt.input, tt.language, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransliterator_CustomReplacements(t *testing.T) {
+	transliterator := NewTransliterator("de", map[string]string{
+		"€":  "eur",
+		"ä":  "a",
+		"ab": "ignored",
+	}, false)
+
+	result := transliterator.Transliterate("Preis in € für Bär")
+	if result != "Preis in eur fuer Bar" {
+		t.Errorf("Transliterate() = %q, want %q", result, "Preis in eur fuer Bar")
+	}
+}
+
+func TestTransliterator_KeepUnicode(t *testing.T) {
+	sanitizer := NewBranchSanitizer()
+	options := SanitizationOptions{Separator: "-", Lowercase: true}
+
+	options.Transliterator = NewTransliterator("", nil, true)
+	if result := sanitizer.Sanitize("修复 Ошибка login!", options); result != "修复-oshibka-login" {
+		t.Errorf("Sanitize() with keepUnicode = %q, want %q", result, "修复-oshibka-login")
+	}
+
+	options.Transliterator = NewTransliterator("", nil, false)
+	if result := sanitizer.Sanitize("修复 Ошибка login!", options); result != "oshibka-login" {
+		t.Errorf("Sanitize() without keepUnicode = %q, want %q", result, "oshibka-login")
+	}
+}
+
+func TestTransliteratorFromAppConfig(t *testing.T) {
+	if TransliteratorFromAppConfig(false, "de", true, nil, false) != nil {
+		t.Error("TransliteratorFromAppConfig() should return nil when disabled")
+	}
+
+	tests := []struct {
+		language      string
+		removeUmlauts bool
+		expected      string
+	}{
+		{"", false, "a"},
+		{"", true, "ae"},
+		{"sv", true, "a"},
+	}
+	for _, tt := range tests {
+		result := TransliteratorFromAppConfig(true, tt.language, tt.removeUmlauts, nil, false).Transliterate("ä")
+		if result != tt.expected {
+			t.Errorf("TransliteratorFromAppConfig(%q, %v) transliterates ä to %q, want %q", tt.language, tt.removeUmlauts, result, tt.expected)
+		}
+	}
+}
+
+func TestTransliterator_GeneratedBranchName(t *testing.T) {
+	generator := NewBranchGenerator(NewBranchSanitizer())
+	info := BranchInfo{Type: "feature", TicketID: "RU-1", Title: "Добавить экспорт в CSV"}
+
+	config := GeneratorConfigFromAppConfig(60, "-", true, false)
+	if result := generator.GenerateNameWithConfig(info, config); result != "feature/RU-1-csv" {
+		t.Errorf("GenerateNameWithConfig() without transliteration = %q, want %q", result, "feature/RU-1-csv")
+	}
+
+	config.Transliterator = NewTransliterator("", nil, false)
+	expected := "feature/RU-1-dobavit-eksport-v-csv"
+	if result := generator.GenerateNameWithConfig(info, config); result != expected {
+		t.Errorf("GenerateNameWithConfig() = %q, want %q", result, expected)
+	}
+}
@@ -0,0 +1,95 @@
+package commit
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Types lists the Conventional Commits types offered when composing a commit
+var Types = []string{"feat", "fix", "docs", "style", "refactor", "perf", "test", "build", "ci", "chore", "revert"}
+
+// builtinTypes maps common branch types to commit types when the configuration has no mapping
+var builtinTypes = map[string]string{
+	"feature":  "feat",
+	"hotfix":   "fix",
+	"bugfix":   "fix",
+	"support":  "chore",
+	"refactor": "refactor",
+}
+
+// tokenPattern matches a commit type or footer token: a word without spaces or colons
+var tokenPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*$`)
+
+// Message is a commit message in the Conventional Commits format:
+// "type(scope)!: subject", an optional body and a footer naming the ticket
+type Message struct {
+	Type        string
+	Scope       string
+	Breaking    bool
+	Subject     string
+	Body        string
+	Ticket      string
+	FooterToken string // e.g. "Refs" for a "Refs: PROJ-123" footer; empty leaves the footer out
+}
+
+// Header returns the first line of the message
+func (m Message) Header() string {
+	var header strings.Builder
+	header.WriteString(m.Type)
+	if scope := strings.TrimSpace(m.Scope); scope != "" {
+		header.WriteString("(" + scope + ")")
+	}
+	if m.Breaking {
+		header.WriteString("!")
+	}
+	if header.Len() > 0 {
+		header.WriteString(": ")
+	}
+	header.WriteString(strings.TrimSpace(m.Subject))
+	return header.String()
+}
+
+// String returns the complete commit message
+func (m Message) String() string {
+	parts := []string{m.Header()}
+	if body := strings.TrimSpace(m.Body); body != "" {
+		parts = append(parts, body)
+	}
+	if m.FooterToken != "" && m.Ticket != "" {
+		parts = append(parts, m.FooterToken+": "+m.Ticket)
+	}
+	return strings.Join(parts, "\n\n") + "\n"
+}
+
+// TypeForBranch returns the commit type for a branch type: the configured mapping,
+// a built-in mapping for common branch types, the branch type itself if it is a
+// commit type, or chore
+func TypeForBranch(branchType string, mapping map[string]string) string {
+	if commitType, ok := mapping[branchType]; ok && commitType != "" {
+		return commitType
+	}
+	if commitType, ok := builtinTypes[branchType]; ok {
+		return commitType
+	}
+	for _, commitType := range Types {
+		if branchType == commitType {
+			return commitType
+		}
+	}
+	return "chore"
+}
+
+// ValidToken reports whether s can be used as a commit type or footer token
+func ValidToken(s string) bool {
+	return tokenPattern.MatchString(s)
+}
+
+// ApplyTemplate writes a commit message given on the command line into the template,
+// replacing {message}, {ticket} and {type}. Messages that already mention the ticket
+// key, and messages without a ticket, are returned unchanged.
+func ApplyTemplate(template, message, ticket, commitType string) string {
+	if ticket == "" || template == "" || strings.Contains(message, ticket) {
+		return message
+	}
+	return strings.NewReplacer("{message}", message, "{ticket}", ticket, "{type}", commitType).Replace(template)
+}
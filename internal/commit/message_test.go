@@ -0,0 +1,102 @@
+package commit
+
+import "testing"
+
+func TestMessage_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+		want    string
+	}{
+		{
+			name:    "type and subject",
+			message: Message{Type: "feat", Subject: "add login form"},
+			want:    "feat: add login form\n",
+		},
+		{
+			name:    "scope, breaking, body and footer",
+			message: Message{Type: "fix", Scope: "auth", Breaking: true, Subject: "reject expired tokens", Body: "Tokens were accepted\nafter expiry.\n", Ticket: "PROJ-1", FooterToken: "Refs"},
+			want:    "fix(auth)!: reject expired tokens\n\nTokens were accepted\nafter expiry.\n\nRefs: PROJ-1\n",
+		},
+		{
+			name:    "no footer token",
+			message: Message{Type: "chore", Subject: "bump deps", Ticket: "PROJ-2"},
+			want:    "chore: bump deps\n",
+		},
+		{
+			name:    "no type",
+			message: Message{Subject: " plain ", Ticket: "PROJ-3", FooterToken: "Jira"},
+			want:    "plain\n\nJira: PROJ-3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.message.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeForBranch(t *testing.T) {
+	mapping := map[string]string{"feature": "feat", "support": "docs", "spike": ""}
+
+	tests := []struct {
+		branchType string
+		want       string
+	}{
+		{"feature", "feat"},
+		{"support", "docs"},
+		{"hotfix", "fix"},
+		{"bugfix", "fix"},
+		{"refactor", "refactor"},
+		{"ci", "ci"},
+		{"spike", "chore"},
+		{"", "chore"},
+	}
+
+	for _, tt := range tests {
+		if got := TypeForBranch(tt.branchType, mapping); got != tt.want {
+			t.Errorf("TypeForBranch(%q) = %q, want %q", tt.branchType, got, tt.want)
+		}
+	}
+}
+
+func TestApplyTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		message  string
+		ticket   string
+		want     string
+	}{
+		{"prefix", "{ticket}: {message}", "Add login", "PROJ-1", "PROJ-1: Add login"},
+		{"conventional", "{type}: {message} ({ticket})", "add login", "PROJ-1", "feat: add login (PROJ-1)"},
+		{"footer", "{message}\n\nRefs: {ticket}", "Add login", "PROJ-1", "Add login\n\nRefs: PROJ-1"},
+		{"already mentioned", "{ticket}: {message}", "PROJ-1 Add login", "PROJ-1", "PROJ-1 Add login"},
+		{"no ticket", "{ticket}: {message}", "Add login", "", "Add login"},
+		{"no template", "", "Add login", "PROJ-1", "Add login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ApplyTemplate(tt.template, tt.message, tt.ticket, "feat"); got != tt.want {
+				t.Errorf("ApplyTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidToken(t *testing.T) {
+	for _, token := range []string{"feat", "Refs", "Jira-Ticket"} {
+		if !ValidToken(token) {
+			t.Errorf("ValidToken(%q) = false", token)
+		}
+	}
+	for _, token := range []string{"", "my type", "refs:", "-x"} {
+		if ValidToken(token) {
+			t.Errorf("ValidToken(%q) = true", token)
+		}
+	}
+}
@@ -1,18 +1,88 @@
 package config
 
 // Config represents the application configuration structure
+// The doc and schema tags feed the generated JSON Schema (see GenerateSchema)
 type Config struct {
-	MaxBranchLength   int                    `yaml:"max_branch_length"`
-	DefaultBranchType string                 `yaml:"default_branch_type"`
-	BranchTypes       map[string]string      `yaml:"branch_types"`
-	Sanitization      SanitizationConfig     `yaml:"sanitization"`
+	Version           int                    `yaml:"version" json:"version" doc:"Configuration format version, upgraded by jiraflow config migrate.\nDo not change this by hand." schema:"minimum=0"`
+	MaxBranchLength   int                    `yaml:"max_branch_length" json:"max_branch_length" doc:"Maximum length for generated branch names (10-200).\nLonger names are truncated in the title part." schema:"minimum=10,maximum=200"`
+	LengthUnit        string                 `yaml:"length_unit" json:"length_unit" doc:"How max_branch_length is counted: bytes, or characters (user-perceived\ncharacters, so é or 修 count once even though they take several bytes)" schema:"enum=bytes|characters"`
+	DefaultBranchType string                 `yaml:"default_branch_type" json:"default_branch_type" doc:"Branch type used when none is specified in non-interactive mode.\nMust be one of the keys defined in branch_types." schema:"minLength=1"`
+	BranchTypes       map[string]string      `yaml:"branch_types" json:"branch_types" doc:"Branch types offered when creating a branch. The key is used as the\nbranch name prefix, e.g. feature/PROJ-123-title. Add your own as needed." schema:"minProperties=1"`
+	TypeOptions       map[string]TypeOptions `yaml:"type_options" json:"type_options" doc:"How branch types are presented, keyed by the branch_types key.\nTypes are listed by order, then by key; types without an order come last."`
+	Sanitization      SanitizationConfig     `yaml:"sanitization" json:"sanitization" doc:"Branch name sanitization settings"`
+	Shortening        ShorteningConfig       `yaml:"shortening" json:"shortening" doc:"How titles that do not fit max_branch_length are shortened: tags are\nremoved, words abbreviated, then stop words and trailing words dropped"`
+	Lint              LintConfig             `yaml:"lint" json:"lint" doc:"Naming policy checks of jiraflow lint"`
+	Hooks             HooksConfig            `yaml:"hooks" json:"hooks" doc:"Git hooks installed with jiraflow hooks install"`
+	Commit            CommitConfig           `yaml:"commit" json:"commit" doc:"Commit messages written by jiraflow commit"`
+	Jira              JiraConfig             `yaml:"jira" json:"jira" doc:"Jira CLI connection used to fetch ticket titles"`
+	Profiles          map[string]Profile     `yaml:"profiles,omitempty" json:"profiles,omitempty" doc:"Named sets of settings applied on top of this configuration.\nSelect one with --profile or JIRAFLOW_PROFILE, or let JiraFlow pick\nthe profile whose match patterns fit the repository."`
+	Extends           string                 `yaml:"extends,omitempty" json:"extends,omitempty" doc:"Shared configuration merged below this file: a path, relative to this\nfile, or a file at a Git revision such as origin/main:.jiraflow/team.yaml"`
+	Locked            []string               `yaml:"locked,omitempty" json:"locked,omitempty" doc:"Keys (or sections) that files and overrides applied after this file\ncannot change, e.g. to enforce a team naming policy"`
 }
 
 // SanitizationConfig holds sanitization-related settings
 type SanitizationConfig struct {
-	Separator     string `yaml:"separator"`
-	Lowercase     bool   `yaml:"lowercase"`
-	RemoveUmlauts bool   `yaml:"remove_umlauts"`
+	Separator               string            `yaml:"separator" json:"separator" doc:"Character used to replace spaces and special characters.\nCommon options: \"-\", \"_\", \".\"" schema:"minLength=1,maxLength=5"`
+	Lowercase               bool              `yaml:"lowercase" json:"lowercase" doc:"Convert branch names to lowercase"`
+	RemoveUmlauts           bool              `yaml:"remove_umlauts" json:"remove_umlauts" doc:"Replace German umlauts (ä → ae, ß → ss)"`
+	Transliterate           bool              `yaml:"transliterate" json:"transliterate" doc:"Convert letters of other scripts to ASCII (ł → l, ж → zh, λ → l)"`
+	TransliterationLanguage string            `yaml:"transliteration_language" json:"transliteration_language" doc:"Language-specific transliteration rules: de (ä → ae), sv and fi (ä → a),\nda and no (å → aa), uk. Empty uses German rules if remove_umlauts is set"`
+	Transliterations        map[string]string `yaml:"transliterations" json:"transliterations" doc:"Custom replacements for single characters, e.g. \"€\": eur"`
+	KeepUnicode             bool              `yaml:"keep_unicode" json:"keep_unicode" doc:"Keep letters that cannot be transliterated (e.g. Chinese or Japanese)\ninstead of removing them"`
+}
+
+// ShorteningConfig holds settings for shortening long titles
+type ShorteningConfig struct {
+	RemovePrefixes bool                `yaml:"remove_prefixes" json:"remove_prefixes" doc:"Remove leading tags like [BE], (UI) or FE: from titles"`
+	Languages      []string            `yaml:"languages" json:"languages" doc:"Languages whose stop words (the, a, der, und, ...) are dropped first.\nBuilt-in: en, de"`
+	StopWords      map[string][]string `yaml:"stop_words" json:"stop_words" doc:"Additional stop words per language. A language defined only here\ncan be listed in languages too"`
+	Abbreviations  map[string]string   `yaml:"abbreviations" json:"abbreviations" doc:"Words replaced by abbreviations, e.g. authentication: auth"`
+}
+
+// TypeOptions controls how a branch type is presented in the type selector and type lists
+type TypeOptions struct {
+	Description string `yaml:"description,omitempty" json:"description,omitempty" doc:"Shown below the type name in the type selector"`
+	Order       int    `yaml:"order,omitempty" json:"order,omitempty" doc:"Position in type lists, lower numbers first"`
+	Icon        string `yaml:"icon,omitempty" json:"icon,omitempty" doc:"Shown before the type name, e.g. an emoji"`
+	Color       string `yaml:"color,omitempty" json:"color,omitempty" doc:"Color of the type name: an ANSI color number (0-255) or #rrggbb"`
+	Hidden      bool   `yaml:"hidden,omitempty" json:"hidden,omitempty" doc:"Leave the type out of the type selector and type lists;\nit can still be used with --type"`
+}
+
+// LintConfig holds settings for checking existing branch names
+type LintConfig struct {
+	Ignore []string `yaml:"ignore" json:"ignore" doc:"Branch names that are not checked, as glob patterns where * does not\nmatch /, e.g. main or release/*"`
+}
+
+// HooksConfig holds settings for the Git hooks
+type HooksConfig struct {
+	CommitPrefix string `yaml:"commit_prefix" json:"commit_prefix" doc:"Added to commit messages by the prepare-commit-msg hook, {ticket} is\nreplaced by the ticket key of the branch. Empty uses \"{ticket}: \""`
+}
+
+// CommitConfig holds settings for composing commit messages
+type CommitConfig struct {
+	Template     string            `yaml:"template" json:"template" doc:"Message written by jiraflow commit -m: {message} is replaced by the\ngiven message, {ticket} by the ticket key and {type} by the commit type"`
+	Types        map[string]string `yaml:"types" json:"types" doc:"Conventional Commits type per branch type, e.g. feature: feat.\nOther branch types use a built-in mapping or chore"`
+	TicketFooter string            `yaml:"ticket_footer" json:"ticket_footer" doc:"Footer naming the ticket in composed commits, e.g. Refs adds\n\"Refs: PROJ-123\". Empty leaves the footer out"`
+}
+
+// JiraConfig holds settings for the Jira CLI
+type JiraConfig struct {
+	CLIPath    string `yaml:"cli_path" json:"cli_path" doc:"Jira CLI executable; empty uses jira from PATH"`
+	ConfigFile string `yaml:"config_file" json:"config_file" doc:"Jira CLI config file, for separate Jira servers or accounts;\nempty uses the Jira CLI default"`
+}
+
+// Profile is a named set of settings that overrides the configuration files when active
+// Settings holds any configuration keys except version and profiles
+type Profile struct {
+	Match    ProfileMatch           `yaml:"match,omitempty" json:"match,omitempty"`
+	Settings map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// ProfileMatch selects a profile automatically from the current repository
+// Patterns use * for any characters; a profile matches when all given patterns match
+type ProfileMatch struct {
+	Remote string `yaml:"remote,omitempty" json:"remote,omitempty" doc:"Pattern matched against the repository's remote URLs"`
+	Path   string `yaml:"path,omitempty" json:"path,omitempty" doc:"Pattern matched against the repository root; ~ is the home directory"`
 }
 
 // ConfigManager interface defines configuration management operations
@@ -3,7 +3,9 @@ package config
 // GetDefaultConfig returns a configuration with sensible default values
 func GetDefaultConfig() *Config {
 	return &Config{
+		Version:           CurrentVersion,
 		MaxBranchLength:   60,
+		LengthUnit:        "bytes",
 		DefaultBranchType: "feature",
 		BranchTypes: map[string]string{
 			"feature": "feature",
@@ -11,10 +13,40 @@ func GetDefaultConfig() *Config {
 			"refactor": "refactor",
 			"support": "support",
 		},
+		TypeOptions: map[string]TypeOptions{
+			"feature":  {Description: "New features and enhancements", Order: 10},
+			"hotfix":   {Description: "Critical bug fixes for production", Order: 20},
+			"refactor": {Description: "Code improvements without changing functionality", Order: 30},
+			"support":  {Description: "Supporting changes like documentation or tooling", Order: 40},
+		},
 		Sanitization: SanitizationConfig{
-			Separator:     "-",
-			Lowercase:     true,
-			RemoveUmlauts: false,
+			Separator:        "-",
+			Lowercase:        true,
+			RemoveUmlauts:    false,
+			Transliterate:    true,
+			Transliterations: map[string]string{},
+		},
+		Shortening: ShorteningConfig{
+			RemovePrefixes: false,
+			Languages:      []string{},
+			StopWords:      map[string][]string{},
+			Abbreviations:  map[string]string{},
+		},
+		Lint: LintConfig{
+			Ignore: []string{"main", "master", "develop", "HEAD"},
+		},
+		Hooks: HooksConfig{
+			CommitPrefix: "{ticket}: ",
+		},
+		Commit: CommitConfig{
+			Template: "{ticket}: {message}",
+			Types: map[string]string{
+				"feature":  "feat",
+				"hotfix":   "fix",
+				"refactor": "refactor",
+				"support":  "chore",
+			},
+			TicketFooter: "Refs",
 		},
 	}
 }
\ No newline at end of file
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LookupValue returns the value of a dotted configuration key in a configuration
+func LookupValue(config *Config, key string) (interface{}, error) {
+	if _, err := ResolveKey(key); err != nil {
+		return nil, err
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var current interface{}
+	if err := yaml.Unmarshal(data, &current); err != nil {
+		return nil, err
+	}
+
+	for _, segment := range strings.Split(key, ".") {
+		tree, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("configuration key '%s' is not set", key)
+		}
+		if current, ok = tree[segment]; !ok {
+			return nil, fmt.Errorf("configuration key '%s' is not set", key)
+		}
+	}
+
+	return current, nil
+}
+
+// SetYAMLValue sets a dotted key in YAML file content, keeping comments and the order of other keys
+// Missing sections are created; empty content yields a new document
+func SetYAMLValue(data []byte, key string, value interface{}) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	if doc.Kind == 0 {
+		root := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		setVersion(root, CurrentVersion)
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("configuration file must contain a YAML mapping")
+	}
+
+	var valueNode yaml.Node
+	if err := valueNode.Encode(value); err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+
+	node := doc.Content[0]
+	segments := strings.Split(key, ".")
+	for i, segment := range segments {
+		last := i == len(segments)-1
+
+		child := mappingValue(node, segment)
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: segment}, child)
+		}
+
+		if last {
+			// Keep comments attached to the replaced value
+			valueNode.HeadComment = child.HeadComment
+			valueNode.LineComment = child.LineComment
+			valueNode.FootComment = child.FootComment
+			*child = valueNode
+			break
+		}
+
+		if child.Kind != yaml.MappingNode {
+			*child = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		node = child
+	}
+
+	return encodeDocument(&doc)
+}
+
+// encodeDocument writes a YAML document node with the indentation used by the default config
+func encodeDocument(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode YAML: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// mappingValue returns the value node for a key in a mapping node, or nil if absent
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLookupValue(t *testing.T) {
+	config := GetDefaultConfig()
+
+	tests := []struct {
+		key     string
+		want    interface{}
+		wantErr bool
+	}{
+		{"max_branch_length", 60, false},
+		{"sanitization.separator", "-", false},
+		{"branch_types.feature", config.BranchTypes["feature"], false},
+		{"branch_types.missing", nil, true},
+		{"unknown", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, err := LookupValue(config, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LookupValue(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("LookupValue(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+
+	section, err := LookupValue(config, "branch_types")
+	if err != nil {
+		t.Fatalf("LookupValue(branch_types) unexpected error: %v", err)
+	}
+	if _, ok := section.(map[string]interface{}); !ok {
+		t.Errorf("LookupValue(branch_types) = %T, want map", section)
+	}
+}
+
+func TestSetYAMLValue(t *testing.T) {
+	original := `# Maximum branch name length
+max_branch_length: 60 # keep short
+
+branch_types:
+  feature: "feature/"
+`
+
+	updated, err := SetYAMLValue([]byte(original), "max_branch_length", 45)
+	if err != nil {
+		t.Fatalf("SetYAMLValue() unexpected error: %v", err)
+	}
+	updated, err = SetYAMLValue(updated, "branch_types.bugfix", "bugfix/")
+	if err != nil {
+		t.Fatalf("SetYAMLValue() unexpected error: %v", err)
+	}
+	updated, err = SetYAMLValue(updated, "sanitization.separator", "_")
+	if err != nil {
+		t.Fatalf("SetYAMLValue() unexpected error: %v", err)
+	}
+
+	content := string(updated)
+	for _, want := range []string{"# Maximum branch name length", "# keep short"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("SetYAMLValue() dropped comment %q:\n%s", want, content)
+		}
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(updated, &config); err != nil {
+		t.Fatalf("SetYAMLValue() produced invalid YAML: %v\n%s", err, content)
+	}
+	if config.MaxBranchLength != 45 {
+		t.Errorf("MaxBranchLength = %d, want 45", config.MaxBranchLength)
+	}
+	if config.BranchTypes["feature"] != "feature/" || config.BranchTypes["bugfix"] != "bugfix/" {
+		t.Errorf("BranchTypes = %v", config.BranchTypes)
+	}
+	if config.Sanitization.Separator != "_" {
+		t.Errorf("Separator = %q, want '_'", config.Sanitization.Separator)
+	}
+}
+
+func TestSetYAMLValue_EmptyAndInvalid(t *testing.T) {
+	updated, err := SetYAMLValue(nil, "max_branch_length", 30)
+	if err != nil {
+		t.Fatalf("SetYAMLValue() unexpected error for empty content: %v", err)
+	}
+	if string(updated) != "version: 1\nmax_branch_length: 30\n" {
+		t.Errorf("SetYAMLValue() = %q", updated)
+	}
+
+	if _, err := SetYAMLValue([]byte("- a\n- b\n"), "max_branch_length", 30); err == nil {
+		t.Error("SetYAMLValue() expected error for non-mapping document")
+	}
+	if _, err := SetYAMLValue([]byte("a: [unclosed\n"), "max_branch_length", 30); err == nil {
+		t.Error("SetYAMLValue() expected error for invalid YAML")
+	}
+}
@@ -0,0 +1,229 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"jiraflow/internal/errors"
+)
+
+// extendsKey is the YAML key naming a shared configuration file merged below the file
+const extendsKey = "extends"
+
+// lockedKey is the YAML key listing keys that files and overrides applied later cannot change
+const lockedKey = "locked"
+
+// maxExtendsDepth limits chains of extended files
+const maxExtendsDepth = 10
+
+// GitFileReader reads a file as of a Git revision, with the path relative to the repository root
+type GitFileReader func(ref, path string) ([]byte, error)
+
+// layerPart is one configuration document taking part in the merge, with the keys it locks
+type layerPart struct {
+	tree   map[string]interface{}
+	source string
+	locked []string
+}
+
+// extendsOrigin is where a configuration document was read from, used to resolve relative extends
+// Documents read from Git have a ref and a directory inside the repository
+type extendsOrigin struct {
+	ref string
+	dir string
+}
+
+// directiveKey reports whether a top-level key configures the file itself rather than a setting
+// Directives cannot be set through overrides or in profiles
+func directiveKey(key string) bool {
+	return key == versionKey || key == profilesKey || key == extendsKey || key == lockedKey
+}
+
+// isGitSpec reports whether an extends value names a file at a Git revision, e.g. origin/main:team.yaml
+func isGitSpec(spec string) bool {
+	ref, _, found := strings.Cut(spec, ":")
+	if !found || ref == "" || filepath.IsAbs(spec) {
+		return false
+	}
+	// A single letter before the colon is a Windows drive rather than a ref
+	return len(ref) > 1 && !strings.HasPrefix(spec, ".") && !strings.HasPrefix(spec, "~")
+}
+
+// expandLayer returns the documents a layer consists of, extended files first
+// Relative extends paths are resolved against the directory of the file declaring them
+func (m *FileConfigManager) expandLayer(tree map[string]interface{}, source string, origin extendsOrigin, seen []string) ([]layerPart, []string, error) {
+	part := layerPart{tree: tree, source: source}
+	if tree == nil {
+		return []layerPart{part}, nil, nil
+	}
+
+	if raw, ok := tree[lockedKey].([]interface{}); ok {
+		for _, item := range raw {
+			part.locked = append(part.locked, fmt.Sprintf("%v", item))
+		}
+	}
+	spec, _ := tree[extendsKey].(string)
+	delete(tree, extendsKey)
+	delete(tree, lockedKey)
+
+	var warnings []string
+	for _, key := range part.locked {
+		if !lockableKey(key) {
+			warnings = append(warnings, fmt.Sprintf("%s: unknown locked key '%s'", source, key))
+		}
+	}
+	if spec == "" {
+		return []layerPart{part}, warnings, nil
+	}
+
+	if len(seen) >= maxExtendsDepth {
+		return nil, nil, errors.NewConfigError(extendsKey, spec, fmt.Sprintf("%s: too many nested extends (%s)", source, strings.Join(seen, " -> ")), true)
+	}
+
+	data, baseSource, baseOrigin, err := m.readExtended(spec, origin)
+	if err != nil {
+		return nil, nil, errors.NewConfigError(extendsKey, spec, fmt.Sprintf("%s: cannot read extended configuration '%s': %v", source, spec, err), true)
+	}
+	for _, previous := range seen {
+		if previous == baseSource {
+			return nil, nil, errors.NewConfigError(extendsKey, spec, fmt.Sprintf("%s: extends cycle (%s -> %s)", source, strings.Join(seen, " -> "), baseSource), true)
+		}
+	}
+
+	baseTree, baseWarnings, err := parseLayer(baseSource, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	parts, nestedWarnings, err := m.expandLayer(baseTree, baseSource, baseOrigin, append(seen, baseSource))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	warnings = append(append(baseWarnings, nestedWarnings...), warnings...)
+	return append(parts, part), warnings, nil
+}
+
+// readExtended reads the file named by an extends value
+// It returns the content, a description of the file for sources and messages, and its origin
+func (m *FileConfigManager) readExtended(spec string, origin extendsOrigin) ([]byte, string, extendsOrigin, error) {
+	spec = ExpandHome(spec)
+
+	ref, file := origin.ref, ""
+	switch {
+	case isGitSpec(spec):
+		ref, file, _ = strings.Cut(spec, ":")
+		file = path.Clean(strings.TrimPrefix(file, "/"))
+	case origin.ref != "" && !filepath.IsAbs(spec):
+		// Relative paths in a file read from Git stay in the same revision
+		file = path.Join(origin.dir, filepath.ToSlash(spec))
+	default:
+		if !filepath.IsAbs(spec) {
+			spec = filepath.Join(origin.dir, spec)
+		}
+		data, err := os.ReadFile(spec)
+		return data, spec, extendsOrigin{dir: filepath.Dir(spec)}, err
+	}
+
+	if m.gitFileReader == nil {
+		return nil, "", extendsOrigin{}, fmt.Errorf("files from Git revisions can only be used inside a Git repository")
+	}
+	data, err := m.gitFileReader(ref, file)
+	return data, ref + ":" + file, extendsOrigin{ref: ref, dir: path.Dir(file)}, err
+}
+
+// lockableKey reports whether a locked entry names a configuration key or a section of keys
+func lockableKey(key string) bool {
+	if _, err := ResolveKey(key); err == nil {
+		return true
+	}
+	for _, info := range Keys() {
+		if strings.HasPrefix(info.Key, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// lockedBy returns the source that locked a key, either directly or through one of its sections
+func lockedBy(locks map[string]string, key string) (string, bool) {
+	for locked, source := range locks {
+		if key == locked || strings.HasPrefix(key, locked+".") {
+			return source, true
+		}
+	}
+	return "", false
+}
+
+// removeLocked returns overlay without locked keys, and a warning for every locked key
+// whose value it would have changed
+func removeLocked(tree, overlay map[string]interface{}, prefix, source string, locks map[string]string) (map[string]interface{}, []string) {
+	if len(locks) == 0 {
+		return overlay, nil
+	}
+
+	var warnings []string
+	result := make(map[string]interface{}, len(overlay))
+	for _, key := range sortedKeys(overlay) {
+		value := overlay[key]
+		path := joinKey(prefix, key)
+
+		if lockSource, ok := lockedBy(locks, path); ok {
+			if !reflect.DeepEqual(treeValue(tree, path), value) {
+				warnings = append(warnings, fmt.Sprintf("'%s' is locked by %s, ignoring the value from %s", path, lockSource, source))
+			}
+			continue
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			filtered, nestedWarnings := removeLocked(tree, nested, path, source, locks)
+			warnings = append(warnings, nestedWarnings...)
+			if lockKey, lockSource, ok := lockedBelow(locks, path); ok && filtered[replaceMarker] == true {
+				// Replacing the mapping would drop the locked key, so it is merged instead
+				delete(filtered, replaceMarker)
+				warnings = append(warnings, fmt.Sprintf("'%s' from %s is merged instead of replaced because '%s' is locked by %s", path, source, lockKey, lockSource))
+			}
+			result[key] = filtered
+			continue
+		}
+		result[key] = value
+	}
+	return result, warnings
+}
+
+// lockedBelow returns a locked key nested below the given key and the file that locked it
+func lockedBelow(locks map[string]string, key string) (string, string, bool) {
+	for _, locked := range sortedLockKeys(locks) {
+		if strings.HasPrefix(locked, key+".") {
+			return locked, locks[locked], true
+		}
+	}
+	return "", "", false
+}
+
+// sortedLockKeys returns the locked keys in sorted order
+func sortedLockKeys(locks map[string]string) []string {
+	keys := make([]string, 0, len(locks))
+	for key := range locks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// treeValue returns the value of a dotted key in a YAML tree, or nil if it is not set
+func treeValue(tree map[string]interface{}, key string) interface{} {
+	var current interface{} = tree
+	for _, segment := range strings.Split(key, ".") {
+		mapping, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = mapping[segment]
+	}
+	return current
+}
@@ -0,0 +1,267 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const teamConfig = `
+version: 1
+max_branch_length: 40
+branch_types:
+  feature: "feature"
+  bugfix: "bugfix"
+sanitization:
+  separator: "-"
+locked:
+  - branch_types
+  - sanitization.separator
+`
+
+func TestFileConfigManager_Load_ExtendsFile(t *testing.T) {
+	dir := t.TempDir()
+	teamPath := filepath.Join(dir, "shared", "team.yaml")
+	userPath := filepath.Join(dir, "jiraflow.yaml")
+	writeConfigFile(t, teamPath, teamConfig)
+	writeConfigFile(t, userPath, `
+version: 1
+extends: shared/team.yaml
+max_branch_length: 50
+default_branch_type: bugfix
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if config.MaxBranchLength != 50 {
+		t.Errorf("MaxBranchLength = %d, want local value to win over the extended file", config.MaxBranchLength)
+	}
+	if config.BranchTypes["bugfix"] != "bugfix" {
+		t.Errorf("BranchTypes = %v, want types from the extended file", config.BranchTypes)
+	}
+	if config.Extends != "" || len(config.Locked) != 0 {
+		t.Errorf("Extends = %q, Locked = %v, want directives removed from the effective configuration", config.Extends, config.Locked)
+	}
+
+	sources := make(map[string]string)
+	for _, source := range manager.GetValueSources() {
+		sources[source.Key] = source.Source
+	}
+	if sources["branch_types.bugfix"] != teamPath || sources["max_branch_length"] != userPath {
+		t.Errorf("sources = %v", sources)
+	}
+}
+
+func TestFileConfigManager_Load_LockedKeys(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, filepath.Join(dir, "team.yaml"), teamConfig)
+	userPath := filepath.Join(dir, "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+version: 1
+extends: team.yaml
+branch_types:
+  hack: "hack"
+sanitization:
+  separator: "-"
+  lowercase: false
+profiles:
+  mine:
+    sanitization:
+      separator: "_"
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetProfile("mine")
+	if err := manager.SetOverrides([]string{"branch_types.fix=fix", "max_branch_length=70"}); err != nil {
+		t.Fatalf("SetOverrides() unexpected error: %v", err)
+	}
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if len(config.BranchTypes) != 2 {
+		t.Errorf("BranchTypes = %v, want only the locked team types", config.BranchTypes)
+	}
+	if config.Sanitization.Separator != "-" {
+		t.Errorf("Separator = %q, want locked value", config.Sanitization.Separator)
+	}
+	if config.Sanitization.Lowercase {
+		t.Error("Lowercase = true, want unlocked local value")
+	}
+	if config.MaxBranchLength != 70 {
+		t.Errorf("MaxBranchLength = %d, want unlocked --set value", config.MaxBranchLength)
+	}
+
+	// The local separator equals the locked value, so only real changes are reported
+	warnings := strings.Join(manager.GetWarnings(), "\n")
+	for _, want := range []string{"'branch_types' is locked", "'sanitization.separator' is locked by " + filepath.Join(dir, "team.yaml") + ", ignoring the value from profile mine", "ignoring the value from --set branch_types.fix"} {
+		if !strings.Contains(warnings, want) {
+			t.Errorf("GetWarnings() = %s, want %q", warnings, want)
+		}
+	}
+	if len(manager.GetWarnings()) != 3 {
+		t.Errorf("GetWarnings() = %v, want 3 warnings", manager.GetWarnings())
+	}
+
+	locked := manager.GetLockedKeys()
+	if len(locked) != 2 || locked[0].Key != "branch_types" || locked[1].Key != "sanitization.separator" {
+		t.Errorf("GetLockedKeys() = %v", locked)
+	}
+}
+
+func TestFileConfigManager_Load_ExtendsGitRevision(t *testing.T) {
+	files := map[string]string{
+		"origin/main:.jiraflow/team.yaml": "version: 1\nextends: base.yaml\nmax_branch_length: 45\nlocked: [max_branch_length]\n",
+		"origin/main:.jiraflow/base.yaml": "version: 1\nbranch_types:\n  story: \"story\"\ndefault_branch_type: story\n",
+	}
+	var requested []string
+
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, "version: 1\nextends: origin/main:.jiraflow/team.yaml\nmax_branch_length: 80\n")
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetGitFileReader(func(ref, path string) ([]byte, error) {
+		requested = append(requested, ref+":"+path)
+		content, ok := files[ref+":"+path]
+		if !ok {
+			return nil, fmt.Errorf("not found")
+		}
+		return []byte(content), nil
+	})
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if config.MaxBranchLength != 45 {
+		t.Errorf("MaxBranchLength = %d, want locked value from the Git revision", config.MaxBranchLength)
+	}
+	if config.DefaultBranchType != "story" {
+		t.Errorf("DefaultBranchType = %q, want value from nested extends", config.DefaultBranchType)
+	}
+	if strings.Join(requested, ",") != "origin/main:.jiraflow/team.yaml,origin/main:.jiraflow/base.yaml" {
+		t.Errorf("requested = %v, want relative extends resolved in the same revision", requested)
+	}
+}
+
+func TestFileConfigManager_Load_ExtendsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:    "missing file",
+			files:   map[string]string{"jiraflow.yaml": "extends: missing.yaml\n"},
+			wantErr: "cannot read extended configuration 'missing.yaml'",
+		},
+		{
+			name:    "cycle",
+			files:   map[string]string{"jiraflow.yaml": "extends: a.yaml\n", "a.yaml": "extends: b.yaml\n", "b.yaml": "extends: a.yaml\n"},
+			wantErr: "extends cycle",
+		},
+		{
+			name:    "git revision outside a repository",
+			files:   map[string]string{"jiraflow.yaml": "extends: origin/main:team.yaml\n"},
+			wantErr: "only be used inside a Git repository",
+		},
+		{
+			name:    "invalid extended file",
+			files:   map[string]string{"jiraflow.yaml": "extends: team.yaml\n", "team.yaml": "max_branch_length: long\n"},
+			wantErr: "team.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				writeConfigFile(t, filepath.Join(dir, name), content)
+			}
+
+			manager := &FileConfigManager{configPath: filepath.Join(dir, "jiraflow.yaml")}
+			_, err := manager.Load()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileConfigManager_Load_UnknownLockedKey(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, "version: 1\nlocked: [sanitization, branch_typs]\n")
+
+	manager := &FileConfigManager{configPath: userPath}
+	if _, err := manager.Load(); err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	warnings := manager.GetWarnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "unknown locked key 'branch_typs'") {
+		t.Errorf("GetWarnings() = %v, want unknown locked key warning", warnings)
+	}
+}
+
+func TestIsGitSpec(t *testing.T) {
+	tests := []struct {
+		spec string
+		want bool
+	}{
+		{"origin/main:.jiraflow/team.yaml", true},
+		{"v1.2:team.yaml", true},
+		{"team.yaml", false},
+		{"../shared/team.yaml", false},
+		{"/etc/jiraflow/team.yaml", false},
+		{"~/team.yaml", false},
+		{"C:/team.yaml", false},
+		{":team.yaml", false},
+	}
+
+	for _, tt := range tests {
+		if got := isGitSpec(tt.spec); got != tt.want {
+			t.Errorf("isGitSpec(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestFileConfigManager_Load_ReplaceLockedMapping(t *testing.T) {
+	dir := t.TempDir()
+	userPath := filepath.Join(dir, "jiraflow.yaml")
+	writeConfigFile(t, filepath.Join(dir, "team.yaml"), `
+version: 1
+branch_types:
+  feature: "feature"
+  bugfix: "bugfix"
+locked:
+  - branch_types.feature
+`)
+	writeConfigFile(t, userPath, `
+version: 1
+extends: team.yaml
+branch_types: !replace
+  hotfix: "hotfix"
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	// The locked type cannot be dropped, so the mapping is merged
+	if len(config.BranchTypes) != 3 || config.BranchTypes["feature"] != "feature" {
+		t.Errorf("BranchTypes = %v, want the locked type kept", config.BranchTypes)
+	}
+	if warnings := strings.Join(manager.GetWarnings(), "\n"); !strings.Contains(warnings, "merged instead of replaced because 'branch_types.feature' is locked") {
+		t.Errorf("GetWarnings() = %q, want a warning about the locked key", warnings)
+	}
+}
@@ -0,0 +1,397 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix is the prefix of environment variables that override configuration keys
+const EnvPrefix = "JIRAFLOW_"
+
+// KeyInfo describes a configuration key derived from the Config struct
+type KeyInfo struct {
+	Key    string
+	Type   reflect.Type
+	EnvVar string
+}
+
+// Keys returns all configuration keys with their types and environment variables
+// Struct fields are expanded into dotted keys; maps and lists are single keys
+func Keys() []KeyInfo {
+	var keys []KeyInfo
+	collectKeys(reflect.TypeOf(Config{}), "", &keys)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Key < keys[j].Key
+	})
+	return keys
+}
+
+// collectKeys walks a struct type and appends a KeyInfo for every leaf field
+func collectKeys(t reflect.Type, prefix string, keys *[]KeyInfo) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := yamlName(field)
+		if name == "" {
+			continue
+		}
+
+		key := joinKey(prefix, name)
+		if directiveKey(key) {
+			// The format version is managed by JiraFlow; profiles, extends and locks are edited in files
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct {
+			collectKeys(field.Type, key, keys)
+			continue
+		}
+
+		*keys = append(*keys, KeyInfo{
+			Key:    key,
+			Type:   field.Type,
+			EnvVar: EnvVarName(key),
+		})
+	}
+}
+
+// EnvVarName returns the environment variable that overrides a configuration key
+func EnvVarName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// yamlName returns the YAML key of a struct field, or "" if the field is not serialized
+func yamlName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	tag := field.Tag.Get("yaml")
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "-" || strings.Contains(options, "inline") {
+		return ""
+	}
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+// ResolveKey returns the Go type addressed by a dotted configuration key
+// Map entries are addressed by appending the map key, e.g. branch_types.bugfix
+func ResolveKey(key string) (reflect.Type, error) {
+	if key == "" {
+		return nil, fmt.Errorf("configuration key cannot be empty")
+	}
+	if key == versionKey {
+		return nil, fmt.Errorf("configuration key '%s' is managed by JiraFlow and cannot be set", key)
+	}
+	if key == profilesKey || strings.HasPrefix(key, profilesKey+".") {
+		return nil, fmt.Errorf("profiles cannot be set individually, edit them with 'jiraflow config edit'")
+	}
+	if key == extendsKey || key == lockedKey {
+		return nil, fmt.Errorf("'%s' applies to a single file and cannot be overridden, edit it with 'jiraflow config edit'", key)
+	}
+
+	t := reflect.TypeOf(Config{})
+	segments := strings.Split(key, ".")
+	for i, segment := range segments {
+		switch t.Kind() {
+		case reflect.Struct:
+			found := false
+			for j := 0; j < t.NumField(); j++ {
+				if yamlName(t.Field(j)) == segment {
+					t = t.Field(j).Type
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unknown configuration key '%s'", key)
+			}
+		case reflect.Map:
+			if segment == "" {
+				return nil, fmt.Errorf("unknown configuration key '%s'", key)
+			}
+			t = t.Elem()
+		default:
+			return nil, fmt.Errorf("unknown configuration key '%s': '%s' is not a section", key, strings.Join(segments[:i], "."))
+		}
+	}
+
+	if t.Kind() == reflect.Struct {
+		return nil, fmt.Errorf("configuration key '%s' is a section, set one of its fields instead", key)
+	}
+
+	return t, nil
+}
+
+// ParseValue converts a string into a value suitable for a key of the given type
+// Lists are comma separated and maps are written as comma separated key=value pairs; both can
+// also be written in YAML flow style, which maps of lists or sections require
+func ParseValue(t reflect.Type, raw string) (interface{}, error) {
+	if kind := t.Kind(); kind == reflect.Slice || kind == reflect.Map {
+		trimmed := strings.TrimSpace(raw)
+		if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+			return parseFlowValue(t, trimmed)
+		}
+		if trimmed != "" && !isScalarType(t.Elem()) {
+			return nil, fmt.Errorf("'%s' must be written in YAML flow style, e.g. %s", raw, flowExample(t))
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return raw, nil
+	case reflect.Int, reflect.Int64, reflect.Int32:
+		value, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not an integer", raw)
+		}
+		return value, nil
+	case reflect.Bool:
+		value, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a boolean (use true or false)", raw)
+		}
+		return value, nil
+	case reflect.Slice:
+		// An empty value is an empty list, so that a list can be cleared
+		items := []interface{}{}
+		for _, part := range splitList(raw) {
+			item, err := ParseValue(t.Elem(), part)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case reflect.Map:
+		items := make(map[string]interface{})
+		for _, part := range splitList(raw) {
+			name, value, found := strings.Cut(part, "=")
+			if !found || strings.TrimSpace(name) == "" {
+				return nil, fmt.Errorf("'%s' is not a key=value pair", part)
+			}
+			item, err := ParseValue(t.Elem(), strings.TrimSpace(value))
+			if err != nil {
+				return nil, err
+			}
+			items[strings.TrimSpace(name)] = item
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("values of type %s cannot be set from a string", t)
+	}
+}
+
+// parseFlowValue parses a list or map written in YAML flow style into a generic YAML value
+// The value is decoded into the key's type first so that wrong types and unknown fields are reported
+func parseFlowValue(t reflect.Type, raw string) (interface{}, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(raw))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(reflect.New(t).Interface()); err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid value: %s", raw, strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+
+	var value interface{}
+	if err := yaml.Unmarshal([]byte(raw), &value); err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid value: %s", raw, strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+	if value == nil {
+		return nil, fmt.Errorf("'%s' is not a valid value", raw)
+	}
+	return value, nil
+}
+
+// isScalarType reports whether values of the type are written as a single string
+func isScalarType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Int, reflect.Int64, reflect.Int32, reflect.Bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// flowExample returns a YAML flow style example for a list or map type
+func flowExample(t reflect.Type) string {
+	element := "value"
+	switch t.Elem().Kind() {
+	case reflect.Slice:
+		element = "[a, b]"
+	case reflect.Map, reflect.Struct:
+		element = "{key: value}"
+	}
+	if t.Kind() == reflect.Slice {
+		return "[" + element + "]"
+	}
+	return "{name: " + element + "}"
+}
+
+// splitList splits a comma separated list, dropping empty items
+func splitList(raw string) []string {
+	var parts []string
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// setTreeValue sets a dotted key in a YAML tree, creating intermediate mappings
+func setTreeValue(tree map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	if tree == nil {
+		tree = make(map[string]interface{})
+	}
+
+	segments := strings.Split(key, ".")
+	current := tree
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			current[segment] = next
+		}
+		current = next
+	}
+	current[segments[len(segments)-1]] = value
+
+	return tree
+}
+
+// unknownKeys returns a message for every key in a mapping node that is not a field of the struct type
+// Nested sections and entries of maps of sections are checked recursively; other map entries are free-form
+func unknownKeys(node *yaml.Node, t reflect.Type, prefix string) []string {
+	var messages []string
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name := node.Content[i].Value
+		value := node.Content[i+1]
+		key := joinKey(prefix, name)
+
+		field, ok := fieldByYAMLName(t, name)
+		if !ok {
+			message := fmt.Sprintf("unknown configuration key '%s' (line %d)", key, node.Content[i].Line)
+			if suggestion := suggestKey(name, fieldNames(t)); suggestion != "" {
+				message += fmt.Sprintf(", did you mean '%s'?", joinKey(prefix, suggestion))
+			}
+			messages = append(messages, message)
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Struct && value.Kind == yaml.MappingNode {
+			messages = append(messages, unknownKeys(value, field.Type, key)...)
+		}
+		if field.Type.Kind() == reflect.Map && field.Type.Elem().Kind() == reflect.Struct && field.Type != profilesType && value.Kind == yaml.MappingNode {
+			for j := 0; j+1 < len(value.Content); j += 2 {
+				if value.Content[j+1].Kind == yaml.MappingNode {
+					messages = append(messages, unknownKeys(value.Content[j+1], field.Type.Elem(), joinKey(key, value.Content[j].Value))...)
+				}
+			}
+		}
+		if field.Type == profilesType && value.Kind == yaml.MappingNode {
+			messages = append(messages, unknownProfileKeys(value, key)...)
+		}
+	}
+	return messages
+}
+
+// fieldByYAMLName returns the struct field serialized under the given YAML key
+func fieldByYAMLName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if yamlName(t.Field(i)) == name {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// fieldNames returns the YAML keys of all serialized fields of a struct type
+func fieldNames(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		if name := yamlName(t.Field(i)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// suggestKey returns the candidate closest to an unknown key, or "" if none is close enough
+func suggestKey(key string, candidates []string) string {
+	best := ""
+	bestDistance := 0
+	for _, candidate := range candidates {
+		distance := levenshtein(strings.ToLower(key), candidate)
+		if best == "" || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	// Allow roughly one typo per three characters
+	if best == "" || bestDistance > len(best)/3+1 {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// unknownProfileKeys checks the settings of every profile in a profiles mapping node
+func unknownProfileKeys(node *yaml.Node, prefix string) []string {
+	var messages []string
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		profile := node.Content[i+1]
+		if profile.Kind != yaml.MappingNode {
+			continue
+		}
+		profileKey := joinKey(prefix, node.Content[i].Value)
+
+		for j := 0; j+1 < len(profile.Content); j += 2 {
+			name := profile.Content[j].Value
+			value := profile.Content[j+1]
+			key := joinKey(profileKey, name)
+
+			if name == profileMatchKey {
+				if value.Kind == yaml.MappingNode {
+					messages = append(messages, unknownKeys(value, reflect.TypeOf(ProfileMatch{}), key)...)
+				}
+				continue
+			}
+			if directiveKey(name) {
+				messages = append(messages, fmt.Sprintf("'%s' cannot be set in a profile (line %d)", key, profile.Content[j].Line))
+				continue
+			}
+
+			single := &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{profile.Content[j], value}}
+			messages = append(messages, unknownKeys(single, reflect.TypeOf(Config{}), profileKey)...)
+		}
+	}
+	return messages
+}
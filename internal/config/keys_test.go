@@ -0,0 +1,200 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	keys := make(map[string]KeyInfo)
+	for _, info := range Keys() {
+		keys[info.Key] = info
+	}
+
+	tests := []struct {
+		key    string
+		kind   reflect.Kind
+		envVar string
+	}{
+		{"max_branch_length", reflect.Int, "JIRAFLOW_MAX_BRANCH_LENGTH"},
+		{"default_branch_type", reflect.String, "JIRAFLOW_DEFAULT_BRANCH_TYPE"},
+		{"branch_types", reflect.Map, "JIRAFLOW_BRANCH_TYPES"},
+		{"sanitization.separator", reflect.String, "JIRAFLOW_SANITIZATION_SEPARATOR"},
+		{"sanitization.lowercase", reflect.Bool, "JIRAFLOW_SANITIZATION_LOWERCASE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			info, ok := keys[tt.key]
+			if !ok {
+				t.Fatalf("Keys() missing %q", tt.key)
+			}
+			if info.Type.Kind() != tt.kind {
+				t.Errorf("Type = %v, want %v", info.Type.Kind(), tt.kind)
+			}
+			if info.EnvVar != tt.envVar {
+				t.Errorf("EnvVar = %q, want %q", info.EnvVar, tt.envVar)
+			}
+		})
+	}
+
+	if _, ok := keys["sanitization"]; ok {
+		t.Error("Keys() should expand sections into their fields")
+	}
+}
+
+func TestResolveKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		kind    reflect.Kind
+		wantErr bool
+	}{
+		{"max_branch_length", reflect.Int, false},
+		{"branch_types.bugfix", reflect.String, false},
+		{"sanitization.remove_umlauts", reflect.Bool, false},
+		{"sanitization", 0, true},
+		{"unknown_key", 0, true},
+		{"max_branch_length.nested", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, err := ResolveKey(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Kind() != tt.kind {
+				t.Errorf("ResolveKey(%q) = %v, want %v", tt.key, got.Kind(), tt.kind)
+			}
+		})
+	}
+}
+
+// testSection is a section type for values of maps of sections
+type testSection struct {
+	Order int `yaml:"order"`
+}
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       reflect.Type
+		raw     string
+		want    interface{}
+		wantErr bool
+	}{
+		{"string", reflect.TypeOf(""), "feature", "feature", false},
+		{"int", reflect.TypeOf(0), " 50 ", 50, false},
+		{"invalid int", reflect.TypeOf(0), "fifty", nil, true},
+		{"bool", reflect.TypeOf(false), "true", true, false},
+		{"invalid bool", reflect.TypeOf(false), "yes please", nil, true},
+		{"list", reflect.TypeOf([]string{}), "a, b,,c", []interface{}{"a", "b", "c"}, false},
+		{"map", reflect.TypeOf(map[string]string{}), "feature=feature/, bugfix=bugfix/",
+			map[string]interface{}{"feature": "feature/", "bugfix": "bugfix/"}, false},
+		{"invalid map", reflect.TypeOf(map[string]string{}), "feature", nil, true},
+		{"empty list", reflect.TypeOf([]string{}), "", []interface{}{}, false},
+		{"empty map", reflect.TypeOf(map[string]string{}), "", map[string]interface{}{}, false},
+		{"flow list", reflect.TypeOf([]string{}), "[a, 'b, c']", []interface{}{"a", "b, c"}, false},
+		{"flow map", reflect.TypeOf(map[string]string{}), "{feature: feature/}",
+			map[string]interface{}{"feature": "feature/"}, false},
+		{"map of lists", reflect.TypeOf(map[string][]string{}), "{en: [the, a], de: [der]}",
+			map[string]interface{}{"en": []interface{}{"the", "a"}, "de": []interface{}{"der"}}, false},
+		{"map of sections", reflect.TypeOf(map[string]testSection{}), "{feature: {order: 1}}",
+			map[string]interface{}{"feature": map[string]interface{}{"order": 1}}, false},
+		{"map of sections without flow style", reflect.TypeOf(map[string]testSection{}), "feature=1", nil, true},
+		{"map of sections with unknown field", reflect.TypeOf(map[string]testSection{}), "{feature: {rank: 1}}", nil, true},
+		{"map of lists with wrong type", reflect.TypeOf(map[string][]string{}), "{en: {the: a}}", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseValue(tt.t, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTreeValue(t *testing.T) {
+	tree := map[string]interface{}{
+		"sanitization": map[string]interface{}{"lowercase": true},
+	}
+
+	tree = setTreeValue(tree, "sanitization.separator", "_")
+	tree = setTreeValue(tree, "max_branch_length", 40)
+
+	sanitization := tree["sanitization"].(map[string]interface{})
+	if sanitization["separator"] != "_" || sanitization["lowercase"] != true {
+		t.Errorf("setTreeValue() sanitization = %v", sanitization)
+	}
+	if tree["max_branch_length"] != 40 {
+		t.Errorf("setTreeValue() max_branch_length = %v", tree["max_branch_length"])
+	}
+}
+
+func TestUnknownKeys(t *testing.T) {
+	root, err := parseDocument([]byte(`
+version: 1
+max_branch_lenght: 50
+default_branch_type: feature
+branch_types:
+  anything: "goes/"
+sanitization:
+  seperator: "_"
+  lowercase: true
+completely_unrelated: true
+`))
+	if err != nil {
+		t.Fatalf("parseDocument() unexpected error: %v", err)
+	}
+
+	warnings := unknownKeys(root, reflect.TypeOf(Config{}), "")
+	want := []string{
+		"unknown configuration key 'max_branch_lenght' (line 3), did you mean 'max_branch_length'?",
+		"unknown configuration key 'sanitization.seperator' (line 8), did you mean 'sanitization.separator'?",
+		"unknown configuration key 'completely_unrelated' (line 10)",
+	}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("unknownKeys() =\n%q\nwant\n%q", warnings, want)
+	}
+}
+
+func TestFileConfigManager_Load_UnknownKeyWarnings(t *testing.T) {
+	userPath := t.TempDir() + "/jiraflow.yaml"
+	writeConfigFile(t, userPath, "version: 1\nmax_lenght: 50\n")
+
+	manager := &FileConfigManager{configPath: userPath}
+	if _, err := manager.Load(); err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	warnings := manager.GetWarnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "max_lenght") || !strings.Contains(warnings[0], userPath) {
+		t.Errorf("GetWarnings() = %v", warnings)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"separator", "seperator", 1},
+		{"kitten", "sitting", 3},
+		{"äöü", "aöü", 1},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"jiraflow/internal/errors"
+)
+
+// Configuration layers in order of increasing precedence
+const (
+	LayerSystem = "system"
+	LayerUser   = "user"
+	LayerRepo   = "repo"
+)
+
+// SystemConfigPath is the system-wide configuration file, overridden by the user config
+const SystemConfigPath = "/etc/jiraflow/jiraflow.yaml"
+
+// RepoConfigFileName is the repository-local configuration file at the Git top-level
+const RepoConfigFileName = ".jiraflow.yaml"
+
+// trustedKeys name programs and files that JiraFlow executes or hands to programs; they are only
+// accepted from the system and user configuration and from overrides, never from a repository
+// or an extended file, so that a cloned repository cannot make JiraFlow run a binary of its choice
+var trustedKeys = []string{"jira.cli_path", "jira.config_file"}
+
+// ReplaceTag marks a mapping that replaces the mapping of lower layers instead of being merged into it
+const ReplaceTag = "!replace"
+
+// replaceMarker is the key that carries ReplaceTag in a generic YAML tree until the tree is decoded
+const replaceMarker = "!replace"
+
+// SourceDefault marks values that come from the built-in defaults
+const SourceDefault = "default"
+
+// ConfigLayer describes a configuration file that takes part in the merged configuration
+type ConfigLayer struct {
+	Name     string
+	Path     string
+	Optional bool
+}
+
+// ValueSource records where an effective configuration value came from
+type ValueSource struct {
+	Key    string
+	Source string
+}
+
+// readLayer reads a configuration layer into a generic YAML tree and its warnings
+// Missing optional layers yield a nil tree without error
+func readLayer(layer ConfigLayer) (map[string]interface{}, []string, error) {
+	data, err := os.ReadFile(layer.Path)
+	if err != nil {
+		if os.IsNotExist(err) && layer.Optional {
+			return nil, nil, nil
+		}
+		return nil, nil, errors.NewConfigError("", layer.Path, fmt.Sprintf("failed to read configuration file: %v", err), true)
+	}
+
+	return parseLayer(layer.Path, data)
+}
+
+// parseLayer parses the content of a configuration file into a generic YAML tree
+// Older formats are migrated in memory; unknown keys are returned as warnings
+func parseLayer(path string, data []byte) (map[string]interface{}, []string, error) {
+	root, err := parseDocument(data)
+	if err != nil {
+		return nil, nil, errors.NewConfigError("", path, fmt.Sprintf("failed to parse YAML configuration %s: %v", path, err), true)
+	}
+	if root == nil {
+		return nil, nil, nil
+	}
+
+	version, _ := documentVersion(root)
+	migrated, err := migrateDocument(root)
+	if err != nil {
+		return nil, nil, errors.NewConfigError(versionKey, path, fmt.Sprintf("%s: %v", path, err), true)
+	}
+
+	var warnings []string
+	if migrated {
+		// Files at a Git revision cannot be rewritten, only updated in their repository
+		hint := fmt.Sprintf("run 'jiraflow config migrate %s' to upgrade the file", path)
+		if !fileExists(path) {
+			hint = "update the file to remove this warning"
+		}
+		warnings = append(warnings, fmt.Sprintf("%s: written for configuration version %d and upgraded in memory on every run; %s", path, version, hint))
+	}
+
+	if err := checkProfileTypes(root); err != nil {
+		return nil, nil, errors.NewConfigError(profilesKey, path, fmt.Sprintf("failed to parse YAML configuration %s: %v", path, err), true)
+	}
+
+	for _, warning := range unknownKeys(root, reflect.TypeOf(Config{}), "") {
+		warnings = append(warnings, fmt.Sprintf("%s: %s", path, warning))
+	}
+
+	// Decode into the typed struct first so type errors are reported against this file
+	var typed Config
+	if err := root.Decode(&typed); err != nil {
+		return nil, nil, errors.NewConfigError("", path, fmt.Sprintf("failed to parse YAML configuration %s: %v", path, err), true)
+	}
+
+	var tree map[string]interface{}
+	if err := root.Decode(&tree); err != nil {
+		return nil, nil, errors.NewConfigError("", path, fmt.Sprintf("failed to parse YAML configuration %s: %v", path, err), true)
+	}
+	markReplaced(root, tree)
+
+	return tree, warnings, nil
+}
+
+// markReplaced adds the replace marker to the mappings of tree that are tagged with ReplaceTag in node
+func markReplaced(node *yaml.Node, tree map[string]interface{}) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		value := node.Content[i+1]
+		nested, ok := tree[node.Content[i].Value].(map[string]interface{})
+		if value.Kind != yaml.MappingNode || !ok {
+			continue
+		}
+		markReplaced(value, nested)
+		if value.Tag == ReplaceTag {
+			nested[replaceMarker] = true
+		}
+	}
+}
+
+// mergeTrees deep-merges overlay into base, recording the source of every leaf value
+// Nested mappings are merged key by key unless tagged with ReplaceTag; scalars and lists in
+// overlay replace those in base
+func mergeTrees(base, overlay map[string]interface{}, prefix, source string, sources map[string]string) map[string]interface{} {
+	if base == nil {
+		base = make(map[string]interface{})
+	}
+
+	for key, value := range overlay {
+		if key == replaceMarker {
+			// Kept so that a merged tree can itself be merged again as an overlay
+			base[key] = value
+			continue
+		}
+		path := joinKey(prefix, key)
+
+		overlayMap, overlayIsMap := value.(map[string]interface{})
+		baseMap, baseIsMap := base[key].(map[string]interface{})
+
+		if overlayIsMap {
+			if !baseIsMap || overlayMap[replaceMarker] == true {
+				clearSources(sources, path)
+				baseMap = nil
+			}
+			base[key] = mergeTrees(baseMap, overlayMap, path, source, sources)
+			continue
+		}
+
+		clearSources(sources, path)
+		base[key] = value
+		sources[path] = source
+	}
+
+	return base
+}
+
+// removeUntrusted deletes the trusted keys from a tree read from an untrusted file, including
+// those in its profiles, and returns a warning for each value that was ignored
+func removeUntrusted(tree map[string]interface{}, source string) []string {
+	var warnings []string
+	remove := func(settings map[string]interface{}, prefix string) {
+		for _, key := range trustedKeys {
+			section, name, _ := strings.Cut(key, ".")
+			values, ok := settings[section].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, ok := values[name]; ok {
+				delete(values, name)
+				warnings = append(warnings, fmt.Sprintf("'%s' can only be set in the user or system configuration, ignoring the value from %s", prefix+key, source))
+			}
+		}
+	}
+
+	remove(tree, "")
+	profiles, _ := tree[profilesKey].(map[string]interface{})
+	for _, name := range sortedKeys(profiles) {
+		if profile, ok := profiles[name].(map[string]interface{}); ok {
+			remove(profile, profilesKey+"."+name+".")
+		}
+	}
+	return warnings
+}
+
+// clearSources removes recorded sources for a key and all keys nested below it
+func clearSources(sources map[string]string, path string) {
+	delete(sources, path)
+	for key := range sources {
+		if len(key) > len(path) && key[:len(path)+1] == path+"." {
+			delete(sources, key)
+		}
+	}
+}
+
+// decodeTree converts a merged YAML tree into a Config
+func decodeTree(tree map[string]interface{}) (*Config, error) {
+	data, err := yaml.Marshal(withoutMarkers(tree))
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// replacing returns a copy of a mapping value marked to replace the mapping of lower layers
+// Other values are returned unchanged, as they always replace
+func replacing(value interface{}) interface{} {
+	mapping, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	result := make(map[string]interface{}, len(mapping)+1)
+	for key, item := range mapping {
+		result[key] = item
+	}
+	result[replaceMarker] = true
+	return result
+}
+
+// withoutMarkers returns a copy of a YAML tree without replace markers
+func withoutMarkers(tree map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tree))
+	for key, value := range tree {
+		if key == replaceMarker {
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			value = withoutMarkers(nested)
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// joinKey joins a dotted key prefix and a key
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// sortedSources returns the recorded value sources sorted by key
+func sortedSources(sources map[string]string) []ValueSource {
+	result := make([]ValueSource, 0, len(sources))
+	for key, source := range sources {
+		result = append(result, ValueSource{Key: key, Source: source})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+// fileExists reports whether a file can be stat'ed
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// defaultTree returns the built-in default configuration as a YAML tree
+func defaultTree() map[string]interface{} {
+	data, err := yaml.Marshal(GetDefaultConfig())
+	if err != nil {
+		return nil
+	}
+
+	var tree map[string]interface{}
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return nil
+	}
+	return tree
+}
+
+// ParseKeyValue validates a configuration key and converts a string value for it
+func ParseKeyValue(key, raw string) (interface{}, error) {
+	t, err := ResolveKey(key)
+	if err != nil {
+		return nil, errors.NewConfigError(key, raw, err.Error(), true)
+	}
+
+	value, err := ParseValue(t, raw)
+	if err != nil {
+		return nil, errors.NewConfigError(key, raw, err.Error(), true)
+	}
+
+	return value, nil
+}
+
+// envOverrides returns overrides for every JIRAFLOW_* environment variable naming a configuration key
+// A value that cannot be parsed is reported with the name of its variable
+func envOverrides(lookup func(string) (string, bool)) ([]Override, error) {
+	var overrides []Override
+	for _, info := range Keys() {
+		raw, ok := lookup(info.EnvVar)
+		if !ok {
+			continue
+		}
+
+		value, err := ParseValue(info.Type, raw)
+		if err != nil {
+			return nil, errors.NewConfigError(info.Key, raw, fmt.Sprintf("invalid value in %s: %v", info.EnvVar, err), true)
+		}
+		overrides = append(overrides, Override{Key: info.Key, Value: value, Source: "env " + info.EnvVar})
+	}
+	return overrides, nil
+}
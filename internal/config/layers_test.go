@@ -0,0 +1,544 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeConfigFile writes YAML content to a file, creating parent directories
+func writeConfigFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+}
+
+func TestFileConfigManager_Load_Layers(t *testing.T) {
+	tempDir := t.TempDir()
+	systemPath := filepath.Join(tempDir, "etc", "jiraflow.yaml")
+	userPath := filepath.Join(tempDir, "home", "jiraflow.yaml")
+	repoRoot := filepath.Join(tempDir, "repo")
+
+	writeConfigFile(t, systemPath, `
+max_branch_length: 80
+default_branch_type: feature
+branch_types:
+  feature: "feature"
+  hotfix: "hotfix"
+sanitization:
+  separator: "_"
+  lowercase: true
+`)
+	writeConfigFile(t, userPath, `
+max_branch_length: 70
+branch_types:
+  refactor: "refactor"
+sanitization:
+  separator: "-"
+`)
+	writeConfigFile(t, filepath.Join(repoRoot, RepoConfigFileName), `
+max_branch_length: 40
+branch_types:
+  bugfix: "bugfix"
+`)
+
+	manager := &FileConfigManager{configPath: userPath, systemPath: systemPath}
+	manager.SetRepoRoot(repoRoot)
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if config.MaxBranchLength != 40 {
+		t.Errorf("MaxBranchLength = %d, want 40 from repo config", config.MaxBranchLength)
+	}
+	if config.Sanitization.Separator != "-" {
+		t.Errorf("Separator = %q, want '-' from user config", config.Sanitization.Separator)
+	}
+	if !config.Sanitization.Lowercase {
+		t.Error("Lowercase = false, want true from system config")
+	}
+	for _, key := range []string{"feature", "hotfix", "refactor", "bugfix"} {
+		if _, ok := config.BranchTypes[key]; !ok {
+			t.Errorf("BranchTypes missing %q, got %v", key, config.BranchTypes)
+		}
+	}
+
+	wantSources := map[string]string{
+		"max_branch_length":      filepath.Join(repoRoot, RepoConfigFileName),
+		"default_branch_type":    systemPath,
+		"branch_types.feature":   systemPath,
+		"branch_types.refactor":  userPath,
+		"branch_types.bugfix":    filepath.Join(repoRoot, RepoConfigFileName),
+		"sanitization.separator": userPath,
+		"sanitization.lowercase": systemPath,
+	}
+	gotSources := make(map[string]string)
+	for _, source := range manager.GetValueSources() {
+		gotSources[source.Key] = source.Source
+	}
+	for key, want := range wantSources {
+		if gotSources[key] != want {
+			t.Errorf("source of %s = %q, want %q", key, gotSources[key], want)
+		}
+	}
+}
+
+func TestFileConfigManager_Load_MissingOptionalLayers(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "jiraflow.yaml")
+
+	manager := &FileConfigManager{
+		configPath: userPath,
+		systemPath: filepath.Join(tempDir, "missing", "system.yaml"),
+	}
+	manager.SetRepoRoot(filepath.Join(tempDir, "not-a-repo"))
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if err := ValidateStrict(config); err != nil {
+		t.Errorf("Load() returned invalid config: %v", err)
+	}
+}
+
+func TestFileConfigManager_Load_InvalidRepoLayer(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "home", "jiraflow.yaml")
+	repoRoot := filepath.Join(tempDir, "repo")
+
+	writeConfigFile(t, filepath.Join(repoRoot, RepoConfigFileName), "max_branch_length: [not, a, number]\n")
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetRepoRoot(repoRoot)
+
+	if _, err := manager.Load(); err == nil {
+		t.Error("Load() expected error for invalid repository config")
+	}
+}
+
+func TestFileConfigManager_Load_FixedValuesAttributedToDefaults(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+max_branch_length: 5
+default_branch_type: feature
+branch_types:
+  feature: "feature"
+sanitization:
+  separator: "-"
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	if _, err := manager.Load(); err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	for _, source := range manager.GetValueSources() {
+		if source.Key == "max_branch_length" && source.Source != SourceDefault {
+			t.Errorf("source of max_branch_length = %q, want %q", source.Source, SourceDefault)
+		}
+	}
+}
+
+func TestFileConfigManager_Load_ReplaceTag(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "home", "jiraflow.yaml")
+	repoRoot := filepath.Join(tempDir, "repo")
+	repoPath := filepath.Join(repoRoot, RepoConfigFileName)
+
+	writeConfigFile(t, userPath, `
+default_branch_type: bugfix
+branch_types:
+  feature: "feature"
+  bugfix: "bugfix"
+  refactor: "refactor"
+`)
+	writeConfigFile(t, repoPath, `
+branch_types: !replace
+  bugfix: "fix"
+  hotfix: "hotfix"
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetRepoRoot(repoRoot)
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(config.BranchTypes) != 2 || config.BranchTypes["bugfix"] != "fix" || config.BranchTypes["hotfix"] != "hotfix" {
+		t.Errorf("BranchTypes = %v, want only the repository types", config.BranchTypes)
+	}
+
+	for _, source := range manager.GetValueSources() {
+		if source.Key == "branch_types.feature" || source.Key == "branch_types.refactor" {
+			t.Errorf("source of replaced key %s = %q, want none", source.Key, source.Source)
+		}
+		if source.Key == "branch_types.hotfix" && source.Source != repoPath {
+			t.Errorf("source of branch_types.hotfix = %q, want %q", source.Source, repoPath)
+		}
+	}
+}
+
+func TestMergeTrees(t *testing.T) {
+	sources := make(map[string]string)
+	base := mergeTrees(nil, map[string]interface{}{
+		"a": 1,
+		"nested": map[string]interface{}{
+			"x": "base",
+			"y": "base",
+		},
+	}, "", "base", sources)
+
+	merged := mergeTrees(base, map[string]interface{}{
+		"nested": map[string]interface{}{
+			"y": "overlay",
+		},
+		"b": true,
+	}, "", "overlay", sources)
+
+	nested := merged["nested"].(map[string]interface{})
+	if nested["x"] != "base" || nested["y"] != "overlay" || merged["a"] != 1 || merged["b"] != true {
+		t.Errorf("mergeTrees() = %v", merged)
+	}
+
+	want := map[string]string{"a": "base", "nested.x": "base", "nested.y": "overlay", "b": "overlay"}
+	for key, source := range want {
+		if sources[key] != source {
+			t.Errorf("sources[%s] = %q, want %q", key, sources[key], source)
+		}
+	}
+
+	// Replacing a mapping with a scalar drops the nested sources
+	mergeTrees(merged, map[string]interface{}{"nested": "flat"}, "", "flat", sources)
+	if _, ok := sources["nested.x"]; ok {
+		t.Error("mergeTrees() kept source of replaced nested key")
+	}
+	if sources["nested"] != "flat" {
+		t.Errorf("sources[nested] = %q, want %q", sources["nested"], "flat")
+	}
+}
+
+func TestMergeTrees_ReplaceMarker(t *testing.T) {
+	sources := make(map[string]string)
+	base := mergeTrees(nil, map[string]interface{}{
+		"types": map[string]interface{}{"a": "a", "b": "b"},
+	}, "", "base", sources)
+
+	merged := mergeTrees(base, map[string]interface{}{
+		"types": map[string]interface{}{replaceMarker: true, "c": "c"},
+	}, "", "overlay", sources)
+
+	types := withoutMarkers(merged)["types"].(map[string]interface{})
+	if len(types) != 1 || types["c"] != "c" {
+		t.Errorf("mergeTrees() with replace marker = %v, want only c", types)
+	}
+	if _, ok := sources["types.a"]; ok || sources["types.c"] != "overlay" {
+		t.Errorf("sources = %v, want only types.c from overlay", sources)
+	}
+}
+
+func TestFileConfigManager_Load_Overrides(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+max_branch_length: 60
+default_branch_type: feature
+branch_types:
+  feature: "feature/"
+sanitization:
+  separator: "-"
+  lowercase: true
+`)
+
+	t.Setenv("JIRAFLOW_MAX_BRANCH_LENGTH", "50")
+	t.Setenv("JIRAFLOW_SANITIZATION_SEPARATOR", "_")
+	t.Setenv("JIRAFLOW_SANITIZATION_LOWERCASE", "false")
+
+	manager := &FileConfigManager{configPath: userPath}
+	if err := manager.SetOverrides([]string{"max_branch_length=45", "branch_types.bugfix=bugfix/"}); err != nil {
+		t.Fatalf("SetOverrides() unexpected error: %v", err)
+	}
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if config.MaxBranchLength != 45 {
+		t.Errorf("MaxBranchLength = %d, want 45 from --set", config.MaxBranchLength)
+	}
+	if config.Sanitization.Separator != "_" {
+		t.Errorf("Separator = %q, want '_' from environment", config.Sanitization.Separator)
+	}
+	if config.Sanitization.Lowercase {
+		t.Error("Lowercase = true, want false from environment")
+	}
+	if config.BranchTypes["feature"] != "feature/" || config.BranchTypes["bugfix"] != "bugfix/" {
+		t.Errorf("BranchTypes = %v, want feature from file and bugfix from --set", config.BranchTypes)
+	}
+
+	wantSources := map[string]string{
+		"max_branch_length":      "--set max_branch_length",
+		"sanitization.separator": "env JIRAFLOW_SANITIZATION_SEPARATOR",
+		"branch_types.feature":   userPath,
+		"branch_types.bugfix":    "--set branch_types.bugfix",
+	}
+	gotSources := make(map[string]string)
+	for _, source := range manager.GetValueSources() {
+		gotSources[source.Key] = source.Source
+	}
+	for key, want := range wantSources {
+		if gotSources[key] != want {
+			t.Errorf("source of %s = %q, want %q", key, gotSources[key], want)
+		}
+	}
+}
+
+func TestFileConfigManager_Load_OverridesAreValidated(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "jiraflow.yaml")
+
+	manager := &FileConfigManager{configPath: userPath}
+	if err := manager.SetOverrides([]string{"max_branch_length=5"}); err != nil {
+		t.Fatalf("SetOverrides() unexpected error: %v", err)
+	}
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if config.MaxBranchLength != GetDefaultConfig().MaxBranchLength {
+		t.Errorf("MaxBranchLength = %d, want out-of-range override corrected to default", config.MaxBranchLength)
+	}
+}
+
+func TestFileConfigManager_Load_InvalidEnvOverride(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("JIRAFLOW_MAX_BRANCH_LENGTH", "long")
+
+	manager := &FileConfigManager{configPath: filepath.Join(tempDir, "jiraflow.yaml")}
+	_, err := manager.Load()
+	if err == nil {
+		t.Fatal("Load() expected error for non-numeric JIRAFLOW_MAX_BRANCH_LENGTH")
+	}
+	if !strings.Contains(err.Error(), "JIRAFLOW_MAX_BRANCH_LENGTH") || !strings.Contains(err.Error(), "'long' is not an integer") {
+		t.Errorf("Load() error = %q, want the variable name and the reason", err)
+	}
+}
+
+func TestFileConfigManager_Load_EmptyEnvListClears(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+version: 1
+shortening:
+  languages: [en]
+  stop_words:
+    en: [the, a]
+`)
+	t.Setenv("JIRAFLOW_SHORTENING_LANGUAGES", "")
+	t.Setenv("JIRAFLOW_SHORTENING_STOP_WORDS", "")
+
+	manager := &FileConfigManager{configPath: userPath}
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(config.Shortening.Languages) != 0 {
+		t.Errorf("Languages = %v, want the list cleared", config.Shortening.Languages)
+	}
+	if len(config.Shortening.StopWords) != 0 {
+		t.Errorf("StopWords = %v, want the mapping cleared", config.Shortening.StopWords)
+	}
+}
+
+func TestFileConfigManager_Load_EnvStopWords(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, "version: 1\n")
+	t.Setenv("JIRAFLOW_SHORTENING_STOP_WORDS", "{en: [please, also], de: [bitte]}")
+
+	manager := &FileConfigManager{configPath: userPath}
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if words := config.Shortening.StopWords["en"]; len(words) != 2 || words[0] != "please" || words[1] != "also" {
+		t.Errorf("StopWords[en] = %v, want [please also]", words)
+	}
+	if words := config.Shortening.StopWords["de"]; len(words) != 1 || words[0] != "bitte" {
+		t.Errorf("StopWords[de] = %v, want [bitte]", words)
+	}
+}
+
+func TestFileConfigManager_Load_EnvMappingReplaces(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+default_branch_type: bugfix
+branch_types:
+  feature: "feature"
+  hotfix: "hotfix"
+`)
+	t.Setenv("JIRAFLOW_BRANCH_TYPES", "{bugfix: fix, chore: chore}")
+
+	manager := &FileConfigManager{configPath: userPath}
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(config.BranchTypes) != 2 || config.BranchTypes["bugfix"] != "fix" || config.BranchTypes["chore"] != "chore" {
+		t.Errorf("BranchTypes = %v, want only the types from JIRAFLOW_BRANCH_TYPES", config.BranchTypes)
+	}
+}
+
+func TestFileConfigManager_SetOverrides_Invalid(t *testing.T) {
+	tests := []string{
+		"max_branch_length",
+		"=50",
+		"unknown=1",
+		"max_branch_length=abc",
+		"sanitization=x",
+	}
+
+	for _, assignment := range tests {
+		t.Run(assignment, func(t *testing.T) {
+			manager := &FileConfigManager{}
+			if err := manager.SetOverrides([]string{assignment}); err == nil {
+				t.Errorf("SetOverrides(%q) expected error", assignment)
+			}
+		})
+	}
+}
+
+func TestFileConfigManager_SetConfigPath_Missing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "custom.yaml")
+
+	manager := &FileConfigManager{}
+	manager.SetConfigPath(path)
+
+	if _, err := manager.Load(); err == nil {
+		t.Error("Load() expected error for missing explicit config file")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("Load() should not create an explicitly chosen config file")
+	}
+}
+
+func TestFileConfigManager_Load_UnwritableUserConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	blocker := filepath.Join(tempDir, "file")
+	writeConfigFile(t, blocker, "")
+
+	// The config directory cannot be created below a regular file
+	manager := &FileConfigManager{configPath: filepath.Join(blocker, "jiraflow", "jiraflow.yaml")}
+	if err := manager.SetOverrides([]string{"max_branch_length=40"}); err != nil {
+		t.Fatalf("SetOverrides() unexpected error: %v", err)
+	}
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if config.MaxBranchLength != 40 {
+		t.Errorf("MaxBranchLength = %d, want 40", config.MaxBranchLength)
+	}
+	if config.DefaultBranchType != GetDefaultConfig().DefaultBranchType {
+		t.Errorf("DefaultBranchType = %q, want built-in default", config.DefaultBranchType)
+	}
+}
+
+func TestFileConfigManager_LoadStrict(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+max_branch_length: 5
+default_branch_type: feature
+branch_types:
+  feature: "feature/"
+sanitization:
+  separator: "/"
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	config, errs, err := manager.LoadStrict()
+	if err != nil {
+		t.Fatalf("LoadStrict() unexpected error: %v", err)
+	}
+	if config.MaxBranchLength != 5 {
+		t.Errorf("MaxBranchLength = %d, want uncorrected 5", config.MaxBranchLength)
+	}
+	if len(errs) != 2 {
+		t.Errorf("LoadStrict() returned %d validation errors, want 2: %v", len(errs), errs)
+	}
+}
+
+func TestFileConfigManager_LoadStrict_MissingUserConfig(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+
+	manager := &FileConfigManager{configPath: userPath}
+	_, errs, err := manager.LoadStrict()
+	if err != nil {
+		t.Fatalf("LoadStrict() unexpected error: %v", err)
+	}
+	if len(errs) != 0 {
+		t.Errorf("LoadStrict() = %v, want built-in defaults to be valid", errs)
+	}
+	if _, err := os.Stat(userPath); !os.IsNotExist(err) {
+		t.Error("LoadStrict() should not create the user config file")
+	}
+}
+
+func TestCheckFileContent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".jiraflow.yaml")
+
+	// A partial file is valid on top of the defaults
+	if err := CheckFileContent(path, []byte("max_branch_length: 40\n")); err != nil {
+		t.Errorf("CheckFileContent() unexpected error: %v", err)
+	}
+
+	if err := CheckFileContent(path, []byte("default_branch_type: missing\n")); err == nil {
+		t.Error("CheckFileContent() expected validation error for an unknown default branch type")
+	}
+
+	if err := CheckFileContent(path, []byte("max_branch_length: [1, 2]\n")); err == nil {
+		t.Error("CheckFileContent() expected parse error")
+	}
+
+	// Environment variables neither break nor repair the file
+	t.Setenv("JIRAFLOW_MAX_BRANCH_LENGTH", "5")
+	if err := CheckFileContent(path, []byte("max_branch_length: 40\n")); err != nil {
+		t.Errorf("CheckFileContent() with an invalid environment override: unexpected error: %v", err)
+	}
+	t.Setenv("JIRAFLOW_MAX_BRANCH_LENGTH", "40")
+	if err := CheckFileContent(path, []byte("max_branch_length: 5\n")); err == nil {
+		t.Error("CheckFileContent() expected validation error for the file despite a valid environment override")
+	}
+}
+
+func TestFileConfigManager_WriteLayer(t *testing.T) {
+	tempDir := t.TempDir()
+	repoRoot := filepath.Join(tempDir, "repo")
+
+	manager := &FileConfigManager{configPath: filepath.Join(tempDir, "jiraflow.yaml")}
+	if err := manager.WriteLayer(LayerRepo, []byte("max_branch_length: 40\n")); err == nil {
+		t.Error("WriteLayer() expected error without repository root")
+	}
+
+	manager.SetRepoRoot(repoRoot)
+	if err := manager.WriteLayer(LayerRepo, []byte("max_branch_length: 40\n")); err != nil {
+		t.Fatalf("WriteLayer() unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(repoRoot, RepoConfigFileName))
+	if err != nil || string(data) != "max_branch_length: 40\n" {
+		t.Errorf("repository config = %q, %v", data, err)
+	}
+}
@@ -4,15 +4,37 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-
-	"gopkg.in/yaml.v3"
+	"strings"
 
 	"jiraflow/internal/errors"
 )
 
 // FileConfigManager implements the ConfigManager interface for file-based configuration
+// The effective configuration is merged from the system, user and repository files
+// in that order, later files overriding earlier ones
 type FileConfigManager struct {
-	configPath string
+	configPath    string
+	systemPath    string
+	repoPath      string
+	explicitPath  bool
+	repoRoot      string
+	remoteURLs    []string
+	profile       string
+	overrides     []Override
+	sources       map[string]string
+	locks         map[string]string
+	gitFileReader GitFileReader
+	warnings      []string
+	active        string
+	activeReason  string
+}
+
+// Override is a configuration value set from the environment or the command line
+// Overrides are applied on top of all configuration files
+type Override struct {
+	Key    string
+	Value  interface{}
+	Source string
 }
 
 // NewFileConfigManager creates a new FileConfigManager instance
@@ -26,6 +48,7 @@ func NewFileConfigManager() *FileConfigManager {
 	configPath := filepath.Join(homeDir, ".config", "jiraflow", "jiraflow.yaml")
 	return &FileConfigManager{
 		configPath: configPath,
+		systemPath: SystemConfigPath,
 	}
 }
 
@@ -34,30 +57,130 @@ func (m *FileConfigManager) GetConfigPath() string {
 	return m.configPath
 }
 
+// SetConfigPath points the manager at an alternate user configuration file
+// Unlike the default location, an explicitly chosen file is never created with defaults
+func (m *FileConfigManager) SetConfigPath(path string) {
+	m.configPath = path
+	m.explicitPath = true
+}
+
+// SetOverrides sets key=value assignments that override all configuration files
+func (m *FileConfigManager) SetOverrides(assignments []string) error {
+	var overrides []Override
+	for _, assignment := range assignments {
+		key, raw, found := strings.Cut(assignment, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return errors.NewConfigError("", assignment, fmt.Sprintf("invalid override '%s', expected key=value", assignment), true)
+		}
+
+		value, err := ParseKeyValue(key, raw)
+		if err != nil {
+			return err
+		}
+		overrides = append(overrides, Override{Key: key, Value: value, Source: "--set " + key})
+	}
+
+	m.overrides = overrides
+	return nil
+}
+
+// SetRepoRoot enables the repository-local configuration file in the given directory
+func (m *FileConfigManager) SetRepoRoot(root string) {
+	m.repoRoot = root
+	if root == "" {
+		m.repoPath = ""
+		return
+	}
+	m.repoPath = filepath.Join(root, RepoConfigFileName)
+}
+
+// SetRemoteURLs sets the repository's remote URLs used to match profiles automatically
+func (m *FileConfigManager) SetRemoteURLs(urls []string) {
+	m.remoteURLs = urls
+}
+
+// SetGitFileReader enables extends values naming a file at a Git revision
+func (m *FileConfigManager) SetGitFileReader(reader GitFileReader) {
+	m.gitFileReader = reader
+}
+
+// SetProfile selects a profile by name instead of matching one automatically
+func (m *FileConfigManager) SetProfile(name string) {
+	m.profile = name
+}
+
+// GetActiveProfile returns the profile applied by the last Load and why it was chosen,
+// or empty strings if no profile is active
+func (m *FileConfigManager) GetActiveProfile() (string, string) {
+	return m.active, m.activeReason
+}
+
+// GetLayers returns the configuration files consulted by Load in order of increasing precedence
+func (m *FileConfigManager) GetLayers() []ConfigLayer {
+	var layers []ConfigLayer
+	if m.systemPath != "" {
+		layers = append(layers, ConfigLayer{Name: LayerSystem, Path: m.systemPath, Optional: true})
+	}
+	layers = append(layers, ConfigLayer{Name: LayerUser, Path: m.configPath})
+	if m.repoPath != "" {
+		layers = append(layers, ConfigLayer{Name: LayerRepo, Path: m.repoPath, Optional: true})
+	}
+	return layers
+}
+
+// GetValueSources returns the file each effective configuration value came from
+// It is populated by Load; values corrected by validation are reported as "default"
+func (m *FileConfigManager) GetValueSources() []ValueSource {
+	return sortedSources(m.sources)
+}
+
+// GetLockedKeys returns the keys locked by the last Load and the file that locked them
+func (m *FileConfigManager) GetLockedKeys() []ValueSource {
+	return sortedSources(m.locks)
+}
+
+// GetWarnings returns problems found by the last Load that did not prevent loading,
+// such as unknown keys
+func (m *FileConfigManager) GetWarnings() []string {
+	return m.warnings
+}
+
 // Load reads and parses the configuration file
+// Files in an older format are upgraded in memory only, with a warning; MigrateFile rewrites them
 func (m *FileConfigManager) Load() (*Config, error) {
 	// Check if config file exists
-	if _, err := os.Stat(m.configPath); os.IsNotExist(err) {
+	if _, err := os.Stat(m.configPath); err != nil {
+		if m.explicitPath {
+			return nil, errors.NewConfigError("", m.configPath, fmt.Sprintf("cannot read configuration file: %v", err), true)
+		}
+
 		// Create default configuration if file doesn't exist
 		if err := m.CreateDefault(); err != nil {
-			return nil, errors.NewConfigError("", nil, fmt.Sprintf("failed to create default configuration: %v", err), true)
+			envs, envErr := envOverrides(os.LookupEnv)
+			if len(m.overrides) == 0 && len(envs) == 0 && envErr == nil {
+				return nil, errors.NewConfigError("", nil, fmt.Sprintf("failed to create default configuration: %v", err), true)
+			}
+			// Read-only environments (CI, containers) configure through overrides only
+			fmt.Fprintf(os.Stderr, "Warning: could not create %s, using built-in defaults\n", m.configPath)
 		}
 	}
 
-	// Read the configuration file
-	data, err := os.ReadFile(m.configPath)
+	config, sources, err := m.merge(nil)
 	if err != nil {
-		return nil, errors.NewConfigError("", m.configPath, fmt.Sprintf("failed to read configuration file: %v", err), true)
+		return nil, err
 	}
 
-	// Parse YAML content
-	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, errors.NewConfigError("", m.configPath, fmt.Sprintf("failed to parse YAML configuration: %v", err), true)
+	if len(m.warnings) > 0 {
+		fmt.Fprintf(os.Stderr, "Configuration warnings:\n")
+		for _, warning := range m.warnings {
+			fmt.Fprintf(os.Stderr, "  - %s\n", warning)
+		}
 	}
 
 	// Validate and fix the loaded configuration
-	result := ValidateAndFix(&config)
+	before := *config
+	result := ValidateAndFix(config)
 	if !result.IsValid() {
 		// If there are validation errors that couldn't be fixed, return the first error
 		return nil, &result.Errors[0]
@@ -69,9 +192,172 @@ func (m *FileConfigManager) Load() (*Config, error) {
 		for _, warning := range result.Warnings {
 			fmt.Fprintf(os.Stderr, "  - %s\n", warning)
 		}
+		markFixedSources(&before, config, sources)
+	}
+
+	m.sources = sources
+	return config, nil
+}
+
+// LoadStrict merges the configuration like Load but reports invalid values instead of fixing them
+// It never creates the user configuration file; a missing default file counts as the built-in defaults
+func (m *FileConfigManager) LoadStrict() (*Config, []ValidationError, error) {
+	config, sources, err := m.merge(nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &config, nil
+	m.sources = sources
+	return config, ValidateAll(config), nil
+}
+
+// CheckFileContent validates the content of a configuration file on its own, on top of the
+// built-in defaults; other files, extended files, environment variables and overrides are ignored
+func CheckFileContent(path string, data []byte) error {
+	tree, _, err := parseLayer(path, data)
+	if err != nil {
+		return err
+	}
+
+	config, err := decodeTree(mergeTrees(defaultTree(), tree, "", path, make(map[string]string)))
+	if err != nil {
+		return errors.NewConfigError("", path, fmt.Sprintf("failed to parse YAML configuration %s: %v", path, err), true)
+	}
+	return ValidateStrict(config)
+}
+
+// LayerPath returns the file of the named configuration layer
+func (m *FileConfigManager) LayerPath(name string) (string, error) {
+	for _, layer := range m.GetLayers() {
+		if layer.Name == name {
+			return layer.Path, nil
+		}
+	}
+
+	if name == LayerRepo {
+		return "", errors.NewConfigError("", name, "repository configuration requires running inside a Git repository", true)
+	}
+	return "", errors.NewConfigError("", name, fmt.Sprintf("unknown configuration layer '%s'", name), true)
+}
+
+// WriteLayer replaces the named layer's file with data, creating its directory if needed
+func (m *FileConfigManager) WriteLayer(name string, data []byte) error {
+	path, err := m.LayerPath(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return errors.NewConfigError("", path, fmt.Sprintf("failed to create configuration directory: %v", err), false)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return errors.NewConfigError("", path, fmt.Sprintf("failed to write configuration: %v", err), false)
+	}
+
+	return nil
+}
+
+// merge combines all configuration layers and overrides into a Config without validating it
+// Layers named in replace are parsed from the given data instead of their files
+func (m *FileConfigManager) merge(replace map[string][]byte) (*Config, map[string]string, error) {
+	m.warnings = nil
+	m.active, m.activeReason = "", ""
+	m.locks = make(map[string]string)
+
+	var tree map[string]interface{}
+	sources := make(map[string]string)
+	for _, layer := range m.GetLayers() {
+		source := layer.Path
+
+		var layerTree map[string]interface{}
+		var layerWarnings []string
+		var err error
+		if data, ok := replace[layer.Name]; ok {
+			layerTree, layerWarnings, err = parseLayer(layer.Path, data)
+		} else if layer.Name == LayerUser && !m.explicitPath && !fileExists(layer.Path) {
+			// The default user file could not be created; use what it would have contained
+			layerTree, source = defaultTree(), SourceDefault
+		} else {
+			layerTree, layerWarnings, err = readLayer(layer)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		m.warnings = append(m.warnings, layerWarnings...)
+
+		// Files named by extends are merged below the file that extends them
+		parts, partWarnings, err := m.expandLayer(layerTree, source, extendsOrigin{dir: filepath.Dir(layer.Path)}, []string{source})
+		if err != nil {
+			return nil, nil, err
+		}
+		m.warnings = append(m.warnings, partWarnings...)
+
+		for _, part := range parts {
+			// Only the system and user files themselves may name programs to run
+			if layer.Name == LayerRepo || part.source != source {
+				m.warnings = append(m.warnings, removeUntrusted(part.tree, part.source)...)
+			}
+			tree = m.mergeUnlocked(tree, part.tree, part.source, sources)
+			for _, key := range part.locked {
+				if _, ok := m.locks[key]; !ok {
+					m.locks[key] = part.source
+				}
+			}
+		}
+	}
+
+	// Apply the active profile on top of the files
+	profiles, _ := tree[profilesKey].(map[string]interface{})
+	name, reason, err := m.selectProfile(profiles)
+	if err != nil {
+		return nil, nil, err
+	}
+	if name != "" {
+		profile, _ := profiles[name].(map[string]interface{})
+		tree = m.mergeUnlocked(tree, profileSettings(profile), "profile "+name, sources)
+		m.active, m.activeReason = name, reason
+	}
+
+	// Apply environment variables, then command line overrides; an override of a whole mapping replaces it
+	envs, err := envOverrides(os.LookupEnv)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, override := range append(envs, m.overrides...) {
+		tree = m.mergeUnlocked(tree, setTreeValue(nil, override.Key, replacing(override.Value)), override.Source, sources)
+	}
+
+	config, err := decodeTree(tree)
+	if err != nil {
+		return nil, nil, errors.NewConfigError("", m.configPath, fmt.Sprintf("failed to parse YAML configuration: %v", err), true)
+	}
+
+	return config, sources, nil
+}
+
+// mergeUnlocked merges overlay into tree like mergeTrees, skipping keys locked by earlier files
+func (m *FileConfigManager) mergeUnlocked(tree, overlay map[string]interface{}, source string, sources map[string]string) map[string]interface{} {
+	overlay, warnings := removeLocked(tree, overlay, "", source, m.locks)
+	m.warnings = append(m.warnings, warnings...)
+	return mergeTrees(tree, overlay, "", source, sources)
+}
+
+// markFixedSources attributes values replaced by ValidateAndFix to the built-in defaults
+func markFixedSources(before, after *Config, sources map[string]string) {
+	if before.MaxBranchLength != after.MaxBranchLength {
+		sources["max_branch_length"] = SourceDefault
+	}
+	if before.DefaultBranchType != after.DefaultBranchType {
+		sources["default_branch_type"] = SourceDefault
+	}
+	if before.Sanitization.Separator != after.Sanitization.Separator {
+		sources["sanitization.separator"] = SourceDefault
+	}
+	if len(before.BranchTypes) == 0 {
+		for key := range after.BranchTypes {
+			sources["branch_types."+key] = SourceDefault
+		}
+	}
 }
 
 // CreateDefault creates the configuration directory and file with default values
@@ -82,32 +368,14 @@ func (m *FileConfigManager) CreateDefault() error {
 		return errors.NewConfigError("", configDir, fmt.Sprintf("failed to create configuration directory: %v", err), false)
 	}
 
-	// Create YAML content with comments
-	yamlContent := `# Maximum branch name length (default: 60)
-max_branch_length: 60
-
-# Default branch type if not specified (default: feature)
-default_branch_type: feature
-
-# Branch type prefixes
-branch_types:
-  feature: "feature/"
-  hotfix: "hotfix/"
-  refactor: "refactor/"
-  support: "support/"
-
-# Character replacements for branch name sanitization
-sanitization:
-  # Replace spaces and special characters with this (default: -)
-  separator: "-"
-  # Convert to lowercase (default: true)
-  lowercase: true
-  # Remove German umlauts (äöüÄÖÜß) (default: false)
-  remove_umlauts: false
-`
+	// Render the defaults with comments so the file always matches GetDefaultConfig
+	yamlContent, err := RenderDefaultYAML()
+	if err != nil {
+		return errors.NewConfigError("", m.configPath, fmt.Sprintf("failed to render default configuration: %v", err), false)
+	}
 
 	// Write to file
-	if err := os.WriteFile(m.configPath, []byte(yamlContent), 0600); err != nil {
+	if err := os.WriteFile(m.configPath, yamlContent, 0600); err != nil {
 		return errors.NewConfigError("", m.configPath, fmt.Sprintf("failed to write default configuration: %v", err), false)
 	}
 
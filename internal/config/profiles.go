@@ -0,0 +1,183 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"jiraflow/internal/errors"
+)
+
+// profilesKey is the YAML key holding the named profiles
+const profilesKey = "profiles"
+
+// profileMatchKey is the key inside a profile holding its match patterns
+const profileMatchKey = "match"
+
+// profilesType is the Go type of the profiles section
+var profilesType = reflect.TypeOf(map[string]Profile{})
+
+// MarshalJSON writes a profile as its settings plus the match patterns, like in YAML files
+func (p Profile) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{}, len(p.Settings)+1)
+	for key, value := range p.Settings {
+		fields[key] = value
+	}
+	if p.Match != (ProfileMatch{}) {
+		fields[profileMatchKey] = p.Match
+	}
+	return json.Marshal(fields)
+}
+
+// selectProfile picks the active profile from the merged profiles section
+// An explicitly requested profile must exist; otherwise the first profile (by name) whose
+// match patterns fit the repository is used. It returns the profile name, or "" for none,
+// and a description of why it was selected.
+func (m *FileConfigManager) selectProfile(profiles map[string]interface{}) (string, string, error) {
+	if m.profile != "" {
+		if _, ok := profiles[m.profile].(map[string]interface{}); !ok {
+			return "", "", errors.NewConfigError(profilesKey, m.profile, fmt.Sprintf("profile '%s' is not defined (available: %s)", m.profile, profileList(profiles)), true)
+		}
+		return m.profile, "selected", nil
+	}
+
+	var matched []string
+	var reasons []string
+	for _, name := range sortedKeys(profiles) {
+		profile, _ := profiles[name].(map[string]interface{})
+		match, _ := profile[profileMatchKey].(map[string]interface{})
+		remote, _ := match["remote"].(string)
+		path, _ := match["path"].(string)
+
+		if reason, ok := m.matchProfile(remote, path); ok {
+			matched = append(matched, name)
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if len(matched) == 0 {
+		return "", "", nil
+	}
+	if len(matched) > 1 {
+		m.warnings = append(m.warnings, fmt.Sprintf("profiles %s all match this repository, using '%s'", strings.Join(matched, ", "), matched[0]))
+	}
+	return matched[0], reasons[0], nil
+}
+
+// matchProfile reports whether the repository fits a profile's remote and path patterns
+// A profile without patterns never matches automatically
+func (m *FileConfigManager) matchProfile(remote, path string) (string, bool) {
+	if remote == "" && path == "" {
+		return "", false
+	}
+
+	var reasons []string
+	if remote != "" {
+		matchedURL := ""
+		for _, url := range m.remoteURLs {
+			if matchPattern(remote, url) {
+				matchedURL = url
+				break
+			}
+		}
+		if matchedURL == "" {
+			return "", false
+		}
+		reasons = append(reasons, "remote "+matchedURL)
+	}
+
+	if path != "" {
+		if m.repoRoot == "" || !matchPattern(ExpandHome(path), filepath.ToSlash(m.repoRoot)) {
+			return "", false
+		}
+		reasons = append(reasons, "path "+m.repoRoot)
+	}
+
+	return "matched " + strings.Join(reasons, " and "), true
+}
+
+// profileSettings returns a profile's settings without its match patterns
+// Keys that cannot be set in a profile are dropped; they are reported as warnings when loading
+func profileSettings(profile map[string]interface{}) map[string]interface{} {
+	settings := make(map[string]interface{}, len(profile))
+	for key, value := range profile {
+		if key != profileMatchKey && !directiveKey(key) {
+			settings[key] = value
+		}
+	}
+	return settings
+}
+
+// checkProfileTypes decodes every profile's settings into a Config to report type errors
+func checkProfileTypes(root *yaml.Node) error {
+	profiles := mappingValue(root, profilesKey)
+	if profiles == nil || profiles.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(profiles.Content); i += 2 {
+		var settings Config
+		if err := profiles.Content[i+1].Decode(&settings); err != nil {
+			return fmt.Errorf("profile '%s': %v", profiles.Content[i].Value, err)
+		}
+	}
+	return nil
+}
+
+// matchPattern matches a value against a pattern where * matches any characters and ? one character
+func matchPattern(pattern, value string) bool {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+
+	matched, err := regexp.MatchString(expr.String(), value)
+	return err == nil && matched
+}
+
+// ExpandHome replaces a leading ~ in a path with the home directory
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(home) + path[1:]
+}
+
+// profileList returns the names of the defined profiles for messages
+func profileList(profiles map[string]interface{}) string {
+	names := withoutMarkers(profiles)
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(sortedKeys(names), ", ")
+}
+
+// sortedKeys returns the keys of a YAML mapping in sorted order
+func sortedKeys(tree map[string]interface{}) []string {
+	keys := make([]string, 0, len(tree))
+	for key := range tree {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,294 @@
+package config
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const profilesConfig = `
+version: 1
+max_branch_length: 60
+default_branch_type: feature
+branch_types:
+  feature: "feature"
+sanitization:
+  separator: "-"
+profiles:
+  oss:
+    match:
+      remote: "*github.com*/oss/*"
+    max_branch_length: 40
+    branch_types:
+      fix: "fix"
+    jira:
+      config_file: "/tmp/oss.yml"
+  work:
+    match:
+      path: "/src/work/*"
+    sanitization:
+      separator: "_"
+  manual:
+    max_branch_length: 30
+`
+
+func TestFileConfigManager_Load_Profiles(t *testing.T) {
+	tests := []struct {
+		name        string
+		profile     string
+		remoteURLs  []string
+		repoRoot    string
+		wantActive  string
+		wantLength  int
+		wantSep     string
+		wantFixType bool
+	}{
+		{"no match", "", []string{"git@gitlab.com:acme/app.git"}, "/home/me/app", "", 60, "-", false},
+		{"remote match", "", []string{"git@gitlab.com:acme/app.git", "https://github.com/oss/tool.git"}, "/home/me/tool", "oss", 40, "-", true},
+		{"path match", "", nil, "/src/work/api", "work", 60, "_", false},
+		{"explicit", "manual", []string{"https://github.com/oss/tool.git"}, "", "manual", 30, "-", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+			writeConfigFile(t, userPath, profilesConfig)
+
+			manager := &FileConfigManager{configPath: userPath, repoRoot: tt.repoRoot}
+			manager.SetRemoteURLs(tt.remoteURLs)
+			manager.SetProfile(tt.profile)
+
+			config, err := manager.Load()
+			if err != nil {
+				t.Fatalf("Load() unexpected error: %v", err)
+			}
+
+			if active, _ := manager.GetActiveProfile(); active != tt.wantActive {
+				t.Errorf("active profile = %q, want %q", active, tt.wantActive)
+			}
+			if config.MaxBranchLength != tt.wantLength {
+				t.Errorf("MaxBranchLength = %d, want %d", config.MaxBranchLength, tt.wantLength)
+			}
+			if config.Sanitization.Separator != tt.wantSep {
+				t.Errorf("Separator = %q, want %q", config.Sanitization.Separator, tt.wantSep)
+			}
+			if _, ok := config.BranchTypes["fix"]; ok != tt.wantFixType {
+				t.Errorf("BranchTypes = %v, want fix type %v", config.BranchTypes, tt.wantFixType)
+			}
+		})
+	}
+}
+
+func TestFileConfigManager_Load_ProfileSourcesAndOverrides(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, profilesConfig)
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetProfile("oss")
+	if err := manager.SetOverrides([]string{"max_branch_length=50"}); err != nil {
+		t.Fatalf("SetOverrides() unexpected error: %v", err)
+	}
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if config.MaxBranchLength != 50 {
+		t.Errorf("MaxBranchLength = %d, want --set to win over the profile", config.MaxBranchLength)
+	}
+	if config.Jira.ConfigFile != "/tmp/oss.yml" {
+		t.Errorf("Jira.ConfigFile = %q, want profile value", config.Jira.ConfigFile)
+	}
+
+	sources := make(map[string]string)
+	for _, source := range manager.GetValueSources() {
+		sources[source.Key] = source.Source
+	}
+	if sources["jira.config_file"] != "profile oss" || sources["branch_types.fix"] != "profile oss" {
+		t.Errorf("profile sources = %v", sources)
+	}
+}
+
+func TestFileConfigManager_Load_UnknownProfile(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, profilesConfig)
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetProfile("missing")
+
+	_, err := manager.Load()
+	if err == nil || !strings.Contains(err.Error(), "manual, oss, work") {
+		t.Errorf("Load() error = %v, want list of available profiles", err)
+	}
+}
+
+func TestFileConfigManager_Load_AmbiguousProfiles(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+version: 1
+profiles:
+  b:
+    match:
+      remote: "*acme*"
+  a:
+    match:
+      remote: "*github.com*"
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetRemoteURLs([]string{"https://github.com/acme/app.git"})
+	if _, err := manager.Load(); err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if active, _ := manager.GetActiveProfile(); active != "a" {
+		t.Errorf("active profile = %q, want first by name", active)
+	}
+	if warnings := manager.GetWarnings(); len(warnings) != 1 || !strings.Contains(warnings[0], "a, b") {
+		t.Errorf("GetWarnings() = %v, want ambiguity warning", warnings)
+	}
+}
+
+func TestParseLayer_ProfileChecks(t *testing.T) {
+	_, warnings, err := parseLayer("test.yaml", []byte(`
+version: 1
+profiles:
+  work:
+    match:
+      remtoe: "*"
+    max_branch_lenght: 40
+    version: 2
+`))
+	if err != nil {
+		t.Fatalf("parseLayer() unexpected error: %v", err)
+	}
+	if len(warnings) != 3 {
+		t.Errorf("parseLayer() warnings = %v, want 3", warnings)
+	}
+
+	if _, _, err := parseLayer("test.yaml", []byte("profiles:\n  work:\n    max_branch_length: long\n")); err == nil {
+		t.Error("parseLayer() expected type error in profile")
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"*github.com*", "git@github.com:acme/app.git", true},
+		{"*github.com/acme/*", "git@github.com:acme/app.git", false},
+		{"https://github.com/acme/app.git", "https://github.com/acme/app.git", true},
+		{"/src/work/*", "/src/work/api", true},
+		{"/src/work/*", "/src/personal/api", false},
+		{"app?", "app1", true},
+		{"a.b", "axb", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchPattern(tt.pattern, tt.value); got != tt.want {
+			t.Errorf("matchPattern(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestProfile_MarshalJSON(t *testing.T) {
+	profile := Profile{
+		Match:    ProfileMatch{Remote: "*acme*"},
+		Settings: map[string]interface{}{"max_branch_length": 40},
+	}
+
+	data, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatalf("MarshalJSON() unexpected error: %v", err)
+	}
+	if string(data) != `{"match":{"remote":"*acme*"},"max_branch_length":40}` {
+		t.Errorf("MarshalJSON() = %s", data)
+	}
+}
+
+func TestFileConfigManager_Load_ReplaceTagInProfile(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, `
+version: 1
+default_branch_type: chore
+branch_types:
+  feature: "feature"
+  bugfix: "bugfix"
+profiles:
+  work:
+    branch_types: !replace
+      chore: "chore"
+`)
+
+	manager := &FileConfigManager{configPath: userPath, profile: "work"}
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(config.BranchTypes) != 1 || config.BranchTypes["chore"] != "chore" {
+		t.Errorf("BranchTypes = %v, want only the profile types", config.BranchTypes)
+	}
+}
+
+func TestFileConfigManager_Load_JiraOnlyFromTrustedLayers(t *testing.T) {
+	dir := t.TempDir()
+	userPath := filepath.Join(dir, "home", "jiraflow.yaml")
+	repoRoot := filepath.Join(dir, "repo")
+	repoPath := filepath.Join(repoRoot, RepoConfigFileName)
+
+	writeConfigFile(t, userPath, `
+version: 1
+jira:
+  cli_path: /usr/local/bin/jira
+profiles:
+  mine:
+    jira:
+      config_file: /home/me/.jira.yml
+`)
+	writeConfigFile(t, filepath.Join(repoRoot, "team.yaml"), `
+version: 1
+jira:
+  config_file: ./team-jira.yml
+`)
+	writeConfigFile(t, repoPath, `
+version: 1
+extends: team.yaml
+max_branch_length: 50
+jira:
+  cli_path: ./evil.sh
+profiles:
+  mine:
+    jira:
+      cli_path: ./evil.sh
+`)
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetRepoRoot(repoRoot)
+	manager.SetProfile("mine")
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if config.MaxBranchLength != 50 {
+		t.Errorf("MaxBranchLength = %d, want other repository settings applied", config.MaxBranchLength)
+	}
+	if config.Jira.CLIPath != "/usr/local/bin/jira" || config.Jira.ConfigFile != "/home/me/.jira.yml" {
+		t.Errorf("Jira = %+v, want the values of the user configuration", config.Jira)
+	}
+
+	warnings := strings.Join(manager.GetWarnings(), "\n")
+	for _, want := range []string{
+		"'jira.cli_path' can only be set in the user or system configuration, ignoring the value from " + repoPath,
+		"'jira.config_file' can only be set in the user or system configuration, ignoring the value from " + filepath.Join(repoRoot, "team.yaml"),
+		"'profiles.mine.jira.cli_path' can only be set",
+	} {
+		if !strings.Contains(warnings, want) {
+			t.Errorf("GetWarnings() = %q, want %q", warnings, want)
+		}
+	}
+}
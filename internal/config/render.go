@@ -0,0 +1,156 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExampleFileName is the example configuration published with JiraFlow
+const ExampleFileName = "jiraflow.example.yaml"
+
+// exampleHeader introduces the published example configuration
+const exampleHeader = `JiraFlow Configuration Example
+This file shows all available configuration options with their default values.
+Copy this file to ~/.config/jiraflow/jiraflow.yaml and customize as needed.`
+
+// exampleFooter shows common customizations below the example configuration
+const exampleFooter = `
+# Advanced Configuration Examples:
+#
+# For teams using different branch prefixes:
+# branch_types:
+#   feat: "feat"
+#   fix: "fix"
+#   docs: "docs"
+#   chore: "chore"
+#
+# Describe, order, decorate or hide branch types in the type selector
+# (hidden types can still be used with --type):
+# type_options:
+#   bugfix:
+#     description: "Non-critical bug fixes"
+#     order: 15
+#     icon: "🐛"
+#     color: "#ff8700"
+#   support:
+#     hidden: true
+#
+# For teams preferring underscores:
+# sanitization:
+#   separator: "_"
+#   lowercase: false
+#
+# For shorter branch names:
+# max_branch_length: 40
+#
+# Share one naming policy across a team: merge a shared file below this one
+# (a path or a file at a Git revision) and lock keys so they cannot be
+# overridden by later files, profiles, environment variables or --set:
+# extends: "origin/main:.jiraflow/team.yaml"
+#
+# and in team.yaml:
+# locked:
+#   - branch_types
+#   - sanitization
+#
+# Profiles for repositories with different conventions. A profile is selected
+# with --profile or JIRAFLOW_PROFILE, or automatically when its match
+# patterns fit the repository's remote URL and/or path:
+# profiles:
+#   oss:
+#     match:
+#       remote: "*github.com*/my-oss-project*"
+#     max_branch_length: 40
+#     branch_types:
+#       fix: "fix"
+#     jira:
+#       config_file: "~/.config/.jira/oss.yml"
+#   work:
+#     match:
+#       path: "~/work/*"
+#     sanitization:
+#       separator: "_"
+`
+
+// RenderDefaultYAML returns the default configuration as YAML, commented from the Config doc tags
+// It is the content written by CreateDefault
+func RenderDefaultYAML() ([]byte, error) {
+	return renderConfigYAML(GetDefaultConfig(), "")
+}
+
+// RenderExampleYAML returns the content of the published example configuration
+func RenderExampleYAML() ([]byte, error) {
+	content, err := renderConfigYAML(GetDefaultConfig(), exampleHeader)
+	if err != nil {
+		return nil, err
+	}
+	return append(content, exampleFooter...), nil
+}
+
+// renderConfigYAML encodes a configuration with every key preceded by its documentation
+func renderConfigYAML(config *Config, header string) ([]byte, error) {
+	var root yaml.Node
+	if err := root.Encode(config); err != nil {
+		return nil, fmt.Errorf("failed to encode configuration: %w", err)
+	}
+	annotateNode(&root, reflect.TypeOf(*config), GetDefaultConfig(), "")
+
+	doc := &yaml.Node{Kind: yaml.DocumentNode, HeadComment: header, Content: []*yaml.Node{&root}}
+	data, err := encodeDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return separateSections(data), nil
+}
+
+// annotateNode attaches the doc tag and default value of each struct field as a head comment
+func annotateNode(node *yaml.Node, t reflect.Type, defaults *Config, prefix string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+		field, ok := fieldByYAMLName(t, keyNode.Value)
+		if !ok {
+			continue
+		}
+
+		key := joinKey(prefix, keyNode.Value)
+		comment := field.Tag.Get("doc")
+		if value, err := LookupValue(defaults, key); err == nil && isScalar(value) && value != "" {
+			comment += fmt.Sprintf("\nDefault: %v", value)
+		}
+		keyNode.HeadComment = comment
+
+		if field.Type.Kind() == reflect.Struct {
+			annotateNode(valueNode, field.Type, defaults, key)
+		}
+	}
+}
+
+// isScalar reports whether a decoded YAML value is a single value rather than a mapping or list
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	default:
+		return true
+	}
+}
+
+// separateSections inserts a blank line before each top-level comment block for readability
+func separateSections(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	var result []string
+	for i, line := range lines {
+		if i > 0 && strings.HasPrefix(line, "#") {
+			previous := lines[i-1]
+			if previous != "" && !strings.HasPrefix(strings.TrimSpace(previous), "#") {
+				result = append(result, "")
+			}
+		}
+		result = append(result, line)
+	}
+	return []byte(strings.Join(result, "\n"))
+}
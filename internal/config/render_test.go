@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFileConfigManager_CreateDefault_MatchesDefaults(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	manager := &FileConfigManager{configPath: userPath}
+
+	if err := manager.CreateDefault(); err != nil {
+		t.Fatalf("CreateDefault() unexpected error: %v", err)
+	}
+
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	if want := GetDefaultConfig(); !reflect.DeepEqual(config, want) {
+		t.Errorf("Load() of created default = %+v, want %+v", config, want)
+	}
+	if warnings := manager.GetWarnings(); len(warnings) != 0 {
+		t.Errorf("created default produced warnings: %v", warnings)
+	}
+}
+
+func TestRenderDefaultYAML_DocumentsEveryKey(t *testing.T) {
+	data, err := RenderDefaultYAML()
+	if err != nil {
+		t.Fatalf("RenderDefaultYAML() unexpected error: %v", err)
+	}
+	content := string(data)
+
+	for _, info := range Keys() {
+		name := info.Key[strings.LastIndex(info.Key, ".")+1:]
+		if !strings.Contains(content, name+":") {
+			t.Errorf("default YAML missing key %q", info.Key)
+		}
+	}
+
+	for _, want := range []string{
+		"# Maximum length for generated branch names (10-200).",
+		"# Default: 60",
+		"  # Convert branch names to lowercase\n  # Default: true\n  lowercase: true",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("default YAML missing %q:\n%s", want, content)
+		}
+	}
+}
+
+// TestRenderExampleYAML_Published checks that the published example matches the defaults
+// Run `go test ./internal/config -run TestRenderExampleYAML_Published -update` after changing Config
+func TestRenderExampleYAML_Published(t *testing.T) {
+	path := filepath.Join("..", "..", ExampleFileName)
+
+	generated, err := RenderExampleYAML()
+	if err != nil {
+		t.Fatalf("RenderExampleYAML() unexpected error: %v", err)
+	}
+
+	if *update {
+		if err := os.WriteFile(path, generated, 0644); err != nil {
+			t.Fatalf("Failed to update %s: %v", path, err)
+		}
+	}
+
+	published, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	if string(published) != string(generated) {
+		t.Errorf("%s is out of date; run go test ./internal/config -run TestRenderExampleYAML_Published -update", ExampleFileName)
+	}
+}
+
+func TestSeparateSections(t *testing.T) {
+	input := "# a\na: 1\n# b\nb:\n  # c\n  c: 2\n# d\nd: 3\n"
+	want := "# a\na: 1\n\n# b\nb:\n  # c\n  c: 2\n\n# d\nd: 3\n"
+
+	if got := string(separateSections([]byte(input))); got != want {
+		t.Errorf("separateSections() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// SchemaFileName is the published JSON Schema for configuration files
+const SchemaFileName = "jiraflow.schema.json"
+
+// GenerateSchema returns a JSON Schema describing configuration files, derived from the Config struct
+// Editors use it for validation and autocompletion of jiraflow.yaml files
+func GenerateSchema() ([]byte, error) {
+	schema := structSchema(reflect.TypeOf(Config{}), "")
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["title"] = "JiraFlow configuration"
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// structSchema returns the schema of a struct type; every property is optional
+// because configuration files are merged and may set only some keys
+func structSchema(t reflect.Type, prefix string) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := yamlName(field)
+		if name == "" {
+			continue
+		}
+		properties[name] = fieldSchema(field, joinKey(prefix, name))
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// fieldSchema returns the schema of a struct field including its description, default and constraints
+func fieldSchema(field reflect.StructField, key string) map[string]interface{} {
+	var schema map[string]interface{}
+	if field.Type == profilesType {
+		schema = map[string]interface{}{"type": "object", "additionalProperties": profileSchema()}
+	} else if field.Type.Kind() == reflect.Struct {
+		schema = structSchema(field.Type, key)
+	} else {
+		schema = typeSchema(field.Type)
+		if value, err := LookupValue(GetDefaultConfig(), key); err == nil {
+			schema["default"] = value
+		}
+	}
+
+	if doc := field.Tag.Get("doc"); doc != "" {
+		schema["description"] = doc
+	}
+
+	for _, constraint := range splitList(field.Tag.Get("schema")) {
+		name, value, _ := strings.Cut(constraint, "=")
+		if name == "enum" {
+			schema[name] = strings.Split(value, "|")
+		} else if number, err := strconv.Atoi(value); err == nil {
+			schema[name] = number
+		} else {
+			schema[name] = value
+		}
+	}
+
+	return schema
+}
+
+// typeSchema returns the schema of a non-struct type
+func typeSchema(t reflect.Type) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t, "")
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// profileSchema returns the schema of a profile: any configuration section plus match patterns
+func profileSchema() map[string]interface{} {
+	t := reflect.TypeOf(Config{})
+	properties := make(map[string]interface{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := yamlName(field)
+		if name == "" || directiveKey(name) {
+			continue
+		}
+
+		// Profile settings override other values, so defaults do not apply
+		property := fieldSchema(field, name)
+		removeDefaults(property)
+		properties[name] = property
+	}
+
+	match := structSchema(reflect.TypeOf(ProfileMatch{}), "")
+	match["description"] = "Select this profile automatically when all patterns match the repository"
+	properties[profileMatchKey] = match
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// removeDefaults deletes default values from a schema and its nested properties
+func removeDefaults(schema map[string]interface{}) {
+	delete(schema, "default")
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		for _, property := range properties {
+			removeDefaults(property.(map[string]interface{}))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update generated files (JSON Schema, example configuration)")
+
+// TestGenerateSchema_Published checks that the published schema matches the Config struct
+// Run `go test ./internal/config -run TestGenerateSchema_Published -update` after changing Config
+func TestGenerateSchema_Published(t *testing.T) {
+	path := filepath.Join("..", "..", SchemaFileName)
+
+	generated, err := GenerateSchema()
+	if err != nil {
+		t.Fatalf("GenerateSchema() unexpected error: %v", err)
+	}
+
+	if *update {
+		if err := os.WriteFile(path, generated, 0644); err != nil {
+			t.Fatalf("Failed to update %s: %v", path, err)
+		}
+	}
+
+	published, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	if string(published) != string(generated) {
+		t.Errorf("%s is out of date; run go test ./internal/config -run TestGenerateSchema_Published -update", SchemaFileName)
+	}
+}
+
+func TestGenerateSchema(t *testing.T) {
+	data, err := GenerateSchema()
+	if err != nil {
+		t.Fatalf("GenerateSchema() unexpected error: %v", err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("GenerateSchema() produced invalid JSON: %v", err)
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	for _, name := range fieldNames(reflect.TypeOf(Config{})) {
+		if _, ok := properties[name]; !ok {
+			t.Errorf("schema missing property %q", name)
+		}
+	}
+
+	maxLength := properties["max_branch_length"].(map[string]interface{})
+	if maxLength["type"] != "integer" || maxLength["minimum"] != float64(10) || maxLength["maximum"] != float64(200) || maxLength["default"] != float64(60) {
+		t.Errorf("max_branch_length schema = %v", maxLength)
+	}
+
+	sanitization := properties["sanitization"].(map[string]interface{})
+	separator := sanitization["properties"].(map[string]interface{})["separator"].(map[string]interface{})
+	if separator["maxLength"] != float64(5) || separator["default"] != "-" {
+		t.Errorf("sanitization.separator schema = %v", separator)
+	}
+	if sanitization["additionalProperties"] != false {
+		t.Error("sanitization schema should reject unknown keys")
+	}
+
+	branchTypes := properties["branch_types"].(map[string]interface{})
+	if branchTypes["type"] != "object" || branchTypes["additionalProperties"].(map[string]interface{})["type"] != "string" {
+		t.Errorf("branch_types schema = %v", branchTypes)
+	}
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// onboardingMarker is the file in the state directory that records that the onboarding was shown
+const onboardingMarker = "onboarded"
+
+// ticketCacheFile is the file in the state directory that caches tickets fetched from Jira
+const ticketCacheFile = "tickets.json"
+
+// historyFile is the file in the state directory that records the branches created by JiraFlow
+const historyFile = "history.jsonl"
+
+// StateDir returns the directory where JiraFlow keeps state between runs
+// It is the directory of the default user configuration file, ~/.config/jiraflow
+func StateDir() string {
+	return filepath.Dir(NewFileConfigManager().GetConfigPath())
+}
+
+// IsFirstRun reports whether the onboarding has not been shown yet
+func IsFirstRun(stateDir string) bool {
+	_, err := os.Stat(filepath.Join(stateDir, onboardingMarker))
+	return os.IsNotExist(err)
+}
+
+// MarkOnboarded records that the onboarding was shown so that it is skipped from now on
+func MarkOnboarded(stateDir string) error {
+	if err := os.MkdirAll(stateDir, 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(stateDir, onboardingMarker), nil, 0600)
+}
+
+// TicketCachePath returns the file that caches tickets fetched from Jira
+func TicketCachePath(stateDir string) string {
+	return filepath.Join(stateDir, ticketCacheFile)
+}
+
+// HistoryPath returns the file that records the branches created by JiraFlow
+func HistoryPath(stateDir string) string {
+	return filepath.Join(stateDir, historyFile)
+}
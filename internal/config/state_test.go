@@ -0,0 +1,29 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestOnboardingState(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "jiraflow")
+
+	if !IsFirstRun(dir) {
+		t.Fatal("IsFirstRun() = false before the onboarding was shown")
+	}
+	if err := MarkOnboarded(dir); err != nil {
+		t.Fatalf("MarkOnboarded() unexpected error: %v", err)
+	}
+	if IsFirstRun(dir) {
+		t.Error("IsFirstRun() = true after MarkOnboarded()")
+	}
+}
+
+func TestStateDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if got, want := StateDir(), filepath.Join(home, ".config", "jiraflow"); got != want {
+		t.Errorf("StateDir() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// DefaultTypeDescription is shown for branch types without a configured description
+const DefaultTypeDescription = "Custom branch type"
+
+// hexColorPattern matches #rgb and #rrggbb colors
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// OrderedBranchTypes returns the branch type keys in display order
+// Types are sorted by their type_options order, types without an order last, then by key
+func (c *Config) OrderedBranchTypes() []string {
+	keys := make([]string, 0, len(c.BranchTypes))
+	for key := range c.BranchTypes {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		oi, oj := c.TypeOptionsFor(keys[i]).Order, c.TypeOptionsFor(keys[j]).Order
+		if oi != oj {
+			if oi == 0 || oj == 0 {
+				return oj == 0
+			}
+			return oi < oj
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// VisibleBranchTypes returns the branch type keys in display order without hidden types
+func (c *Config) VisibleBranchTypes() []string {
+	var keys []string
+	for _, key := range c.OrderedBranchTypes() {
+		if !c.TypeOptionsFor(key).Hidden {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// TypeOptionsFor returns the presentation options of a branch type
+// Options of the built-in types fall back to their defaults field by field, so configuration
+// files written before type_options existed keep the built-in descriptions and order
+func (c *Config) TypeOptionsFor(key string) TypeOptions {
+	options := GetDefaultConfig().TypeOptions[key]
+	configured := c.TypeOptions[key]
+	if configured.Description != "" {
+		options.Description = configured.Description
+	}
+	if configured.Order != 0 {
+		options.Order = configured.Order
+	}
+	if configured.Icon != "" {
+		options.Icon = configured.Icon
+	}
+	if configured.Color != "" {
+		options.Color = configured.Color
+	}
+	options.Hidden = configured.Hidden
+
+	if options.Description == "" {
+		options.Description = DefaultTypeDescription
+	}
+	return options
+}
+
+// validColor reports whether a color is an ANSI color number or a hex color
+func validColor(color string) bool {
+	if number, err := strconv.Atoi(color); err == nil {
+		return number >= 0 && number <= 255
+	}
+	return hexColorPattern.MatchString(color)
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConfig_OrderedBranchTypes(t *testing.T) {
+	config := GetDefaultConfig()
+	config.BranchTypes["bugfix"] = "bugfix"
+	config.BranchTypes["chore"] = "chore"
+	config.BranchTypes["docs"] = "docs"
+	config.TypeOptions = map[string]TypeOptions{
+		"bugfix":  {Order: 15},
+		"support": {Hidden: true},
+		"hotfix":  {Order: 5},
+	}
+
+	// Built-in types keep their default order unless overridden; types without an order come last
+	want := []string{"hotfix", "feature", "bugfix", "refactor", "support", "chore", "docs"}
+	for i := 0; i < 5; i++ {
+		if got := config.OrderedBranchTypes(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("OrderedBranchTypes() = %v, want %v", got, want)
+		}
+	}
+
+	wantVisible := []string{"hotfix", "feature", "bugfix", "refactor", "chore", "docs"}
+	if got := config.VisibleBranchTypes(); !reflect.DeepEqual(got, wantVisible) {
+		t.Errorf("VisibleBranchTypes() = %v, want %v", got, wantVisible)
+	}
+}
+
+func TestConfig_TypeOptionsFor(t *testing.T) {
+	config := GetDefaultConfig()
+	config.BranchTypes["bugfix"] = "bugfix"
+	config.TypeOptions = map[string]TypeOptions{
+		"feature": {Icon: "*", Color: "42"},
+	}
+
+	feature := config.TypeOptionsFor("feature")
+	want := TypeOptions{Description: "New features and enhancements", Order: 10, Icon: "*", Color: "42"}
+	if feature != want {
+		t.Errorf("TypeOptionsFor(feature) = %+v, want %+v", feature, want)
+	}
+
+	if got := config.TypeOptionsFor("bugfix"); got != (TypeOptions{Description: DefaultTypeDescription}) {
+		t.Errorf("TypeOptionsFor(bugfix) = %+v, want default description only", got)
+	}
+}
+
+func TestValidColor(t *testing.T) {
+	tests := []struct {
+		color string
+		want  bool
+	}{
+		{"0", true},
+		{"255", true},
+		{"256", false},
+		{"-1", false},
+		{"#fff", true},
+		{"#ff8700", true},
+		{"#ff870", false},
+		{"orange", false},
+	}
+
+	for _, tt := range tests {
+		if got := validColor(tt.color); got != tt.want {
+			t.Errorf("validColor(%q) = %v, want %v", tt.color, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTypeOptions(t *testing.T) {
+	config := GetDefaultConfig()
+	config.TypeOptions = map[string]TypeOptions{
+		"feature": {Hidden: true},
+		"hotfix":  {Color: "orange"},
+		"missing": {Order: 1},
+	}
+
+	errs := ValidateAll(config)
+	wantFields := []string{"type_options.feature.hidden", "type_options.hotfix.color", "type_options"}
+	if len(errs) != len(wantFields) {
+		t.Fatalf("ValidateAll() returned %d errors, want %d: %v", len(errs), len(wantFields), errs)
+	}
+	for i, field := range wantFields {
+		if errs[i].Field != field {
+			t.Errorf("error %d field = %q, want %q", i, errs[i].Field, field)
+		}
+	}
+
+	result := ValidateAndFix(config)
+	if !result.IsValid() || !result.Fixed || len(result.Warnings) != 2 {
+		t.Fatalf("ValidateAndFix() = %+v, want two fixed values", result)
+	}
+	if config.TypeOptions["feature"].Hidden || config.TypeOptions["hotfix"].Color != "" {
+		t.Errorf("TypeOptions = %+v, want default type shown and invalid color removed", config.TypeOptions)
+	}
+}
+
+func TestUnknownKeys_TypeOptions(t *testing.T) {
+	root, err := parseDocument([]byte("type_options:\n  feature:\n    descripton: x\n    icon: y\n"))
+	if err != nil {
+		t.Fatalf("parseDocument() unexpected error: %v", err)
+	}
+
+	warnings := unknownKeys(root, reflect.TypeOf(Config{}), "")
+	want := []string{"unknown configuration key 'type_options.feature.descripton' (line 3), did you mean 'type_options.feature.description'?"}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("unknownKeys() = %q, want %q", warnings, want)
+	}
+}
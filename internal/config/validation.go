@@ -2,8 +2,13 @@ package config
 
 import (
 	"fmt"
+	"path"
+	"sort"
 	"strings"
+	"unicode/utf8"
 
+	"jiraflow/internal/branch"
+	"jiraflow/internal/commit"
 	"jiraflow/internal/errors"
 )
 
@@ -44,6 +49,14 @@ func ValidateAndFix(config *Config) *ValidationResult {
 		result.Fixed = true
 	}
 
+	// Validate and fix length_unit, an empty value counts bytes like older versions
+	if config.LengthUnit != "" && config.LengthUnit != "bytes" && config.LengthUnit != "characters" {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("length_unit '%s' is not bytes or characters, using default '%s'", config.LengthUnit, defaults.LengthUnit))
+		config.LengthUnit = defaults.LengthUnit
+		result.Fixed = true
+	}
+
 	// Validate and fix branch_types
 	if len(config.BranchTypes) == 0 {
 		result.Warnings = append(result.Warnings, 
@@ -86,6 +99,54 @@ func ValidateAndFix(config *Config) *ValidationResult {
 		result.Fixed = true
 	}
 
+	// Validate and fix type_options
+	for _, key := range sortedTypeOptionKeys(config) {
+		options := config.TypeOptions[key]
+		if options.Color != "" && !validColor(options.Color) {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("type_options.%s.color '%s' is not a valid color, using the default color", key, options.Color))
+			options.Color = ""
+			result.Fixed = true
+		}
+		if options.Hidden && key == config.DefaultBranchType {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("default branch type '%s' cannot be hidden, showing it", key))
+			options.Hidden = false
+			result.Fixed = true
+		}
+		config.TypeOptions[key] = options
+	}
+
+	// Validate and fix shortening languages
+	var languages []string
+	for _, language := range config.Shortening.Languages {
+		if !knownLanguage(config, language) {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("shortening language '%s' has no stop words, ignoring it", language))
+			result.Fixed = true
+			continue
+		}
+		languages = append(languages, language)
+	}
+	if len(languages) != len(config.Shortening.Languages) {
+		config.Shortening.Languages = languages
+	}
+
+	// Validate and fix lint ignore patterns
+	var ignore []string
+	for _, pattern := range config.Lint.Ignore {
+		if _, err := path.Match(pattern, ""); err != nil {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("lint ignore pattern '%s' is malformed, ignoring it", pattern))
+			result.Fixed = true
+			continue
+		}
+		ignore = append(ignore, pattern)
+	}
+	if len(ignore) != len(config.Lint.Ignore) {
+		config.Lint.Ignore = ignore
+	}
+
 	// Validate and fix sanitization settings
 	if config.Sanitization.Separator == "" {
 		result.Warnings = append(result.Warnings,
@@ -116,59 +177,227 @@ func ValidateAndFix(config *Config) *ValidationResult {
 		}
 	}
 
+	// Validate and fix transliteration settings
+	if language := config.Sanitization.TransliterationLanguage; language != "" && !knownTransliterationLanguage(language) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("transliteration language '%s' has no rules, using generic rules", language))
+		config.Sanitization.TransliterationLanguage = ""
+		result.Fixed = true
+	}
+	for _, character := range sortedTransliterationKeys(config) {
+		if utf8.RuneCountInString(character) != 1 {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("transliteration for '%s' must replace a single character, ignoring it", character))
+			delete(config.Sanitization.Transliterations, character)
+			result.Fixed = true
+		}
+	}
+
+	// Validate and fix commit settings
+	if config.Commit.Template != "" && !strings.Contains(config.Commit.Template, "{message}") {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("commit template '%s' does not contain {message}, using default '%s'", config.Commit.Template, defaults.Commit.Template))
+		config.Commit.Template = defaults.Commit.Template
+		result.Fixed = true
+	}
+	for _, key := range sortedCommitTypeKeys(config) {
+		if commitType := config.Commit.Types[key]; !commit.ValidToken(commitType) {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("commit type '%s' for branch type '%s' is not a single word, ignoring it", commitType, key))
+			delete(config.Commit.Types, key)
+			result.Fixed = true
+		}
+	}
+	if footer := config.Commit.TicketFooter; footer != "" && !commit.ValidToken(footer) {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("commit ticket footer '%s' is not a single word, using default '%s'", footer, defaults.Commit.TicketFooter))
+		config.Commit.TicketFooter = defaults.Commit.TicketFooter
+		result.Fixed = true
+	}
+
 	return result
 }
 
 // ValidateStrict performs strict validation without fixing values
+// It returns the first error reported by ValidateAll
 func ValidateStrict(config *Config) error {
 	if config == nil {
 		return fmt.Errorf("configuration cannot be nil")
 	}
 
+	if errs := ValidateAll(config); len(errs) > 0 {
+		return &errs[0]
+	}
+
+	return nil
+}
+
+// ValidateAll performs strict validation without fixing values and returns every error found
+func ValidateAll(config *Config) []ValidationError {
+	if config == nil {
+		return []ValidationError{*errors.NewConfigError("config", nil, "configuration cannot be nil", false)}
+	}
+
+	var errs []ValidationError
+
 	// Validate max_branch_length
 	if config.MaxBranchLength < 10 || config.MaxBranchLength > 200 {
-		return errors.NewConfigError("max_branch_length", config.MaxBranchLength, "must be between 10 and 200", true)
+		errs = append(errs, *errors.NewConfigError("max_branch_length", config.MaxBranchLength, "must be between 10 and 200", true))
+	}
+
+	// Validate length_unit
+	if config.LengthUnit != "" && config.LengthUnit != "bytes" && config.LengthUnit != "characters" {
+		errs = append(errs, *errors.NewConfigError("length_unit", config.LengthUnit, "must be bytes or characters", true))
 	}
 
 	// Validate branch_types
 	if len(config.BranchTypes) == 0 {
-		return errors.NewConfigError("branch_types", config.BranchTypes, "cannot be empty", true)
+		errs = append(errs, *errors.NewConfigError("branch_types", config.BranchTypes, "cannot be empty", true))
 	}
 
-	for key, value := range config.BranchTypes {
+	branchTypeKeys := make([]string, 0, len(config.BranchTypes))
+	for key := range config.BranchTypes {
+		branchTypeKeys = append(branchTypeKeys, key)
+	}
+	sort.Strings(branchTypeKeys)
+
+	for _, key := range branchTypeKeys {
 		if key == "" {
-			return errors.NewConfigError("branch_types", key, "branch type key cannot be empty", false)
+			errs = append(errs, *errors.NewConfigError("branch_types", key, "branch type key cannot be empty", false))
 		}
-		if value == "" {
-			return errors.NewConfigError("branch_types", fmt.Sprintf("key '%s'", key), "branch type value cannot be empty", true)
+		if config.BranchTypes[key] == "" {
+			errs = append(errs, *errors.NewConfigError("branch_types", fmt.Sprintf("key '%s'", key), "branch type value cannot be empty", true))
 		}
 	}
 
 	// Validate default_branch_type
 	if config.DefaultBranchType == "" {
-		return errors.NewConfigError("default_branch_type", config.DefaultBranchType, "cannot be empty", true)
+		errs = append(errs, *errors.NewConfigError("default_branch_type", config.DefaultBranchType, "cannot be empty", true))
+	} else if _, exists := config.BranchTypes[config.DefaultBranchType]; !exists {
+		errs = append(errs, *errors.NewConfigError("default_branch_type", config.DefaultBranchType, "must exist in branch_types", true))
+	}
+
+	// Validate type_options
+	for _, key := range sortedTypeOptionKeys(config) {
+		options := config.TypeOptions[key]
+		if _, exists := config.BranchTypes[key]; !exists {
+			errs = append(errs, *errors.NewConfigError("type_options", key, "must refer to a key in branch_types", true))
+		}
+		if options.Color != "" && !validColor(options.Color) {
+			errs = append(errs, *errors.NewConfigError("type_options."+key+".color", options.Color, "must be an ANSI color number (0-255) or #rrggbb", true))
+		}
+		if options.Hidden && key == config.DefaultBranchType {
+			errs = append(errs, *errors.NewConfigError("type_options."+key+".hidden", options.Hidden, "the default branch type cannot be hidden", true))
+		}
+	}
+
+	// Validate shortening languages
+	for _, language := range config.Shortening.Languages {
+		if !knownLanguage(config, language) {
+			errs = append(errs, *errors.NewConfigError("shortening.languages", language, fmt.Sprintf("must be a built-in language (%s) or defined in shortening.stop_words", strings.Join(branch.BuiltinStopWordLanguages(), ", ")), true))
+		}
 	}
 
-	if _, exists := config.BranchTypes[config.DefaultBranchType]; !exists {
-		return errors.NewConfigError("default_branch_type", config.DefaultBranchType, "must exist in branch_types", true)
+	// Validate lint ignore patterns
+	for _, pattern := range config.Lint.Ignore {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, *errors.NewConfigError("lint.ignore", pattern, "must be a valid glob pattern", true))
+		}
 	}
 
 	// Validate sanitization settings
 	if config.Sanitization.Separator == "" {
-		return errors.NewConfigError("sanitization.separator", config.Sanitization.Separator, "cannot be empty", true)
+		errs = append(errs, *errors.NewConfigError("sanitization.separator", config.Sanitization.Separator, "cannot be empty", true))
 	}
 
 	if len(config.Sanitization.Separator) > 5 {
-		return errors.NewConfigError("sanitization.separator", config.Sanitization.Separator, "cannot be longer than 5 characters", true)
+		errs = append(errs, *errors.NewConfigError("sanitization.separator", config.Sanitization.Separator, "cannot be longer than 5 characters", true))
 	}
 
 	// Check for problematic characters in separator
 	problematicChars := []string{"/", "\\", ":", "*", "?", "\"", "<", ">", "|", " "}
 	for _, char := range problematicChars {
 		if strings.Contains(config.Sanitization.Separator, char) {
-			return errors.NewConfigError("sanitization.separator", config.Sanitization.Separator, fmt.Sprintf("cannot contain problematic character '%s'", char), true)
+			errs = append(errs, *errors.NewConfigError("sanitization.separator", config.Sanitization.Separator, fmt.Sprintf("cannot contain problematic character '%s'", char), true))
+			break
 		}
 	}
 
-	return nil
-}
\ No newline at end of file
+	// Validate transliteration settings
+	if language := config.Sanitization.TransliterationLanguage; language != "" && !knownTransliterationLanguage(language) {
+		errs = append(errs, *errors.NewConfigError("sanitization.transliteration_language", language, fmt.Sprintf("must be empty or one of %s", strings.Join(branch.TransliterationLanguages(), ", ")), true))
+	}
+	for _, character := range sortedTransliterationKeys(config) {
+		if utf8.RuneCountInString(character) != 1 {
+			errs = append(errs, *errors.NewConfigError("sanitization.transliterations", character, "keys must be a single character", true))
+		}
+	}
+
+	// Validate commit settings
+	if config.Commit.Template != "" && !strings.Contains(config.Commit.Template, "{message}") {
+		errs = append(errs, *errors.NewConfigError("commit.template", config.Commit.Template, "must contain {message}", true))
+	}
+	for _, key := range sortedCommitTypeKeys(config) {
+		if commitType := config.Commit.Types[key]; !commit.ValidToken(commitType) {
+			errs = append(errs, *errors.NewConfigError("commit.types."+key, commitType, "must be a single word like feat or fix", true))
+		}
+	}
+	if footer := config.Commit.TicketFooter; footer != "" && !commit.ValidToken(footer) {
+		errs = append(errs, *errors.NewConfigError("commit.ticket_footer", footer, "must be empty or a single word like Refs", true))
+	}
+
+	return errs
+}
+
+// sortedTypeOptionKeys returns the keys of the type_options section in sorted order
+func sortedTypeOptionKeys(config *Config) []string {
+	keys := make([]string, 0, len(config.TypeOptions))
+	for key := range config.TypeOptions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// knownLanguage reports whether a shortening language has built-in or configured stop words
+func knownLanguage(config *Config, language string) bool {
+	if _, ok := config.Shortening.StopWords[language]; ok {
+		return true
+	}
+	for _, builtin := range branch.BuiltinStopWordLanguages() {
+		if language == builtin {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedTransliterationKeys returns the characters of the sanitization.transliterations section in sorted order
+func sortedTransliterationKeys(config *Config) []string {
+	keys := make([]string, 0, len(config.Sanitization.Transliterations))
+	for key := range config.Sanitization.Transliterations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedCommitTypeKeys returns the branch types of the commit.types section in sorted order
+func sortedCommitTypeKeys(config *Config) []string {
+	keys := make([]string, 0, len(config.Commit.Types))
+	for key := range config.Commit.Types {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// knownTransliterationLanguage reports whether a language has built-in transliteration rules
+func knownTransliterationLanguage(language string) bool {
+	for _, builtin := range branch.TransliterationLanguages() {
+		if language == builtin {
+			return true
+		}
+	}
+	return false
+}
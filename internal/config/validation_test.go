@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"jiraflow/internal/branch"
 	"jiraflow/internal/errors"
 )
 
@@ -737,4 +738,168 @@ func TestGetDefaultConfig(t *testing.T) {
 	if err := ValidateStrict(config); err != nil {
 		t.Errorf("Default config should pass strict validation: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+func TestGetDefaultConfig_TransliteratesTitles(t *testing.T) {
+	config := GetDefaultConfig()
+	generatorConfig := branch.GeneratorConfigFromAppConfig(config.MaxBranchLength, config.Sanitization.Separator, config.Sanitization.Lowercase, config.Sanitization.RemoveUmlauts)
+	generatorConfig.Transliterator = branch.TransliteratorFromAppConfig(
+		config.Sanitization.Transliterate,
+		config.Sanitization.TransliterationLanguage,
+		config.Sanitization.RemoveUmlauts,
+		config.Sanitization.Transliterations,
+		config.Sanitization.KeepUnicode,
+	)
+
+	// Titles in other scripts no longer collapse to the ticket key
+	got := branch.NewBranchGenerator(branch.NewBranchSanitizer()).GenerateNameWithConfig(branch.BranchInfo{
+		Type:     "feature",
+		TicketID: "PROJ-1",
+		Title:    "Исправить ошибку",
+	}, generatorConfig)
+	if want := "feature/PROJ-1-ispravit-oshibku"; got != want {
+		t.Errorf("generated name with default config = %q, want %q", got, want)
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	config := &Config{
+		MaxBranchLength:   5,
+		DefaultBranchType: "missing",
+		BranchTypes: map[string]string{
+			"feature": "feature/",
+			"hotfix":  "",
+		},
+		Sanitization: SanitizationConfig{
+			Separator: "/",
+		},
+	}
+
+	errs := ValidateAll(config)
+
+	wantFields := []string{"max_branch_length", "branch_types", "default_branch_type", "sanitization.separator"}
+	if len(errs) != len(wantFields) {
+		t.Fatalf("ValidateAll() returned %d errors, want %d: %v", len(errs), len(wantFields), errs)
+	}
+	for i, field := range wantFields {
+		if errs[i].Field != field {
+			t.Errorf("error %d field = %q, want %q", i, errs[i].Field, field)
+		}
+	}
+
+	if err := ValidateStrict(config); err == nil || !strings.Contains(err.Error(), "max_branch_length") {
+		t.Errorf("ValidateStrict() = %v, want first error of ValidateAll", err)
+	}
+
+	if errs := ValidateAll(GetDefaultConfig()); len(errs) != 0 {
+		t.Errorf("ValidateAll(defaults) = %v, want no errors", errs)
+	}
+}
+
+func TestValidateShorteningLanguages(t *testing.T) {
+	config := GetDefaultConfig()
+	config.Shortening.Languages = []string{"en", "team", "xx"}
+	config.Shortening.StopWords = map[string][]string{"team": {"pls"}}
+
+	errs := ValidateAll(config)
+	if len(errs) != 1 || errs[0].Field != "shortening.languages" || errs[0].Value != "xx" {
+		t.Fatalf("ValidateAll() = %v, want one error for the unknown language", errs)
+	}
+
+	result := ValidateAndFix(config)
+	if !result.IsValid() || !result.Fixed {
+		t.Fatalf("ValidateAndFix() = %+v, want fixed result", result)
+	}
+	if strings.Join(config.Shortening.Languages, ",") != "en,team" {
+		t.Errorf("Languages = %v, want unknown language removed", config.Shortening.Languages)
+	}
+}
+
+func TestValidateTransliteration(t *testing.T) {
+	config := GetDefaultConfig()
+	config.Sanitization.TransliterationLanguage = "xx"
+	config.Sanitization.Transliterations = map[string]string{"€": "eur", "ab": "x"}
+
+	errs := ValidateAll(config)
+	if len(errs) != 2 || errs[0].Field != "sanitization.transliteration_language" || errs[1].Field != "sanitization.transliterations" {
+		t.Fatalf("ValidateAll() = %v, want errors for the language and the multi-character key", errs)
+	}
+
+	result := ValidateAndFix(config)
+	if !result.IsValid() || !result.Fixed {
+		t.Fatalf("ValidateAndFix() = %+v, want fixed result", result)
+	}
+	if config.Sanitization.TransliterationLanguage != "" {
+		t.Errorf("TransliterationLanguage = %q, want generic rules", config.Sanitization.TransliterationLanguage)
+	}
+	if _, exists := config.Sanitization.Transliterations["ab"]; exists || len(config.Sanitization.Transliterations) != 1 {
+		t.Errorf("Transliterations = %v, want only the single-character key", config.Sanitization.Transliterations)
+	}
+}
+
+func TestValidateLengthUnit(t *testing.T) {
+	for _, unit := range []string{"", "bytes", "characters"} {
+		config := GetDefaultConfig()
+		config.LengthUnit = unit
+		if errs := ValidateAll(config); len(errs) != 0 {
+			t.Errorf("ValidateAll() with length_unit %q = %v, want no errors", unit, errs)
+		}
+	}
+
+	config := GetDefaultConfig()
+	config.LengthUnit = "runes"
+	errs := ValidateAll(config)
+	if len(errs) != 1 || errs[0].Field != "length_unit" {
+		t.Fatalf("ValidateAll() = %v, want one length_unit error", errs)
+	}
+
+	result := ValidateAndFix(config)
+	if !result.Fixed || config.LengthUnit != "bytes" {
+		t.Errorf("ValidateAndFix() left length_unit %q, want bytes", config.LengthUnit)
+	}
+}
+
+func TestValidateLintIgnore(t *testing.T) {
+	config := GetDefaultConfig()
+	config.Lint.Ignore = []string{"main", "release/[", "release/*"}
+
+	errs := ValidateAll(config)
+	if len(errs) != 1 || errs[0].Field != "lint.ignore" || errs[0].Value != "release/[" {
+		t.Fatalf("ValidateAll() = %v, want one error for the malformed pattern", errs)
+	}
+
+	result := ValidateAndFix(config)
+	if !result.IsValid() || !result.Fixed {
+		t.Fatalf("ValidateAndFix() = %+v, want fixed result", result)
+	}
+	if strings.Join(config.Lint.Ignore, ",") != "main,release/*" {
+		t.Errorf("Ignore = %v, want malformed pattern removed", config.Lint.Ignore)
+	}
+}
+
+func TestValidateCommit(t *testing.T) {
+	config := GetDefaultConfig()
+	config.Commit.Template = "{ticket}"
+	config.Commit.Types["spike"] = "spike work"
+	config.Commit.TicketFooter = "Refs:"
+
+	errs := ValidateAll(config)
+	var fields []string
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	if strings.Join(fields, ",") != "commit.template,commit.types.spike,commit.ticket_footer" {
+		t.Fatalf("ValidateAll() fields = %v", fields)
+	}
+
+	result := ValidateAndFix(config)
+	if !result.IsValid() || !result.Fixed {
+		t.Fatalf("ValidateAndFix() = %+v, want fixed result", result)
+	}
+	if config.Commit.Template != "{ticket}: {message}" || config.Commit.TicketFooter != "Refs" {
+		t.Errorf("Commit = %+v, want defaults restored", config.Commit)
+	}
+	if _, ok := config.Commit.Types["spike"]; ok {
+		t.Errorf("Types = %v, want invalid type removed", config.Commit.Types)
+	}
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"jiraflow/internal/errors"
+)
+
+// CurrentVersion is the configuration format version written by this release
+// Files without a version field are treated as version 0
+const CurrentVersion = 1
+
+// versionKey is the YAML key holding the configuration format version
+const versionKey = "version"
+
+// migration upgrades a configuration document from one version to the next
+type migration struct {
+	from        int
+	description string
+	apply       func(root *yaml.Node) error
+}
+
+// migrations is the chain of upgrades, one per version, applied in order
+var migrations = []migration{
+	{
+		from:        0,
+		description: "add version field",
+		// Version 1 introduced the version field; the remaining keys are unchanged
+		apply: func(root *yaml.Node) error { return nil },
+	},
+}
+
+// documentVersion returns the version field of a configuration document
+func documentVersion(root *yaml.Node) (int, error) {
+	node := mappingValue(root, versionKey)
+	if node == nil {
+		return 0, nil
+	}
+
+	var version int
+	if err := node.Decode(&version); err != nil || version < 0 {
+		return 0, fmt.Errorf("version must be a non-negative integer, got '%s'", node.Value)
+	}
+	return version, nil
+}
+
+// migrateDocument upgrades a configuration document to CurrentVersion
+// It reports whether the document was changed
+func migrateDocument(root *yaml.Node) (bool, error) {
+	version, err := documentVersion(root)
+	if err != nil {
+		return false, err
+	}
+	if version > CurrentVersion {
+		return false, fmt.Errorf("configuration version %d is newer than supported version %d, upgrade JiraFlow to use it", version, CurrentVersion)
+	}
+	if version == CurrentVersion {
+		return false, nil
+	}
+
+	for _, m := range migrations {
+		if m.from < version {
+			continue
+		}
+		if err := m.apply(root); err != nil {
+			return false, fmt.Errorf("failed to migrate configuration from version %d (%s): %w", m.from, m.description, err)
+		}
+	}
+
+	setVersion(root, CurrentVersion)
+	return true, nil
+}
+
+// setVersion sets the version field, adding it as the first key if missing
+func setVersion(root *yaml.Node, version int) {
+	value := fmt.Sprintf("%d", version)
+	if node := mappingValue(root, versionKey); node != nil {
+		node.Kind, node.Tag, node.Value = yaml.ScalarNode, "!!int", value
+		return
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: versionKey}
+	node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: value}
+	root.Content = append([]*yaml.Node{key, node}, root.Content...)
+}
+
+// parseDocument parses configuration file content into its root mapping node
+// Empty content yields nil without error
+func parseDocument(data []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("configuration must be a YAML mapping")
+	}
+	return root, nil
+}
+
+// MigrateFile upgrades a configuration file in an older format in place, keeping a backup of the original
+// It returns the backup path, or "" if the file was already current or empty
+func MigrateFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.NewConfigError("", path, fmt.Sprintf("failed to read configuration file: %v", err), true)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return "", errors.NewConfigError("", path, fmt.Sprintf("failed to parse YAML configuration %s: %v", path, err), true)
+	}
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		return "", nil
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return "", errors.NewConfigError("", path, fmt.Sprintf("failed to parse YAML configuration %s: configuration must be a YAML mapping", path), true)
+	}
+
+	changed, err := migrateDocument(doc.Content[0])
+	if err != nil {
+		return "", errors.NewConfigError(versionKey, path, fmt.Sprintf("%s: %v", path, err), true)
+	}
+	if !changed {
+		return "", nil
+	}
+
+	upgraded, err := encodeDocument(&doc)
+	if err != nil {
+		return "", err
+	}
+
+	backupPath := fmt.Sprintf("%s.v%d.bak", path, mustVersion(data))
+	if err := os.WriteFile(backupPath, data, 0600); err != nil {
+		return "", errors.NewConfigError("", backupPath, fmt.Sprintf("failed to back up configuration before migration: %v", err), true)
+	}
+	if err := os.WriteFile(path, upgraded, 0600); err != nil {
+		return "", errors.NewConfigError("", path, fmt.Sprintf("failed to write migrated configuration: %v", err), true)
+	}
+
+	return backupPath, nil
+}
+
+// mustVersion returns the version of configuration content, or 0 if it cannot be determined
+func mustVersion(data []byte) int {
+	root, err := parseDocument(data)
+	if err != nil || root == nil {
+		return 0
+	}
+	version, _ := documentVersion(root)
+	return version
+}
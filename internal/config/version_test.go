@@ -0,0 +1,167 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrateDocument(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantChanged bool
+		wantErr     bool
+	}{
+		{"unversioned", "max_branch_length: 50\n", true, false},
+		{"explicit version 0", "version: 0\nmax_branch_length: 50\n", true, false},
+		{"current", "version: 1\nmax_branch_length: 50\n", false, false},
+		{"newer", "version: 99\n", false, true},
+		{"invalid", "version: latest\n", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := parseDocument([]byte(tt.content))
+			if err != nil {
+				t.Fatalf("parseDocument() unexpected error: %v", err)
+			}
+
+			changed, err := migrateDocument(root)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("migrateDocument() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("migrateDocument() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			version, err := documentVersion(root)
+			if err != nil || version != CurrentVersion {
+				t.Errorf("version after migration = %d, %v; want %d", version, err, CurrentVersion)
+			}
+		})
+	}
+}
+
+func TestMigrations_Chain(t *testing.T) {
+	for i, m := range migrations {
+		if m.from != i {
+			t.Errorf("migration %d upgrades from version %d, want %d", i, m.from, i)
+		}
+	}
+	if len(migrations) != CurrentVersion {
+		t.Errorf("%d migrations for CurrentVersion %d", len(migrations), CurrentVersion)
+	}
+}
+
+func TestFileConfigManager_Load_OutdatedUserConfig(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	original := `# Team settings
+max_branch_length: 50
+default_branch_type: feature
+branch_types:
+  feature: "feature/"
+sanitization:
+  separator: "-"
+`
+	writeConfigFile(t, userPath, original)
+
+	manager := &FileConfigManager{configPath: userPath}
+	config, err := manager.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if config.Version != CurrentVersion || config.MaxBranchLength != 50 {
+		t.Errorf("Load() = version %d, max_branch_length %d", config.Version, config.MaxBranchLength)
+	}
+
+	// Loading migrates in memory only and says how to upgrade the file
+	if data, _ := os.ReadFile(userPath); string(data) != original {
+		t.Errorf("Load() modified the user config:\n%s", data)
+	}
+	if _, err := os.Stat(userPath + ".v0.bak"); err == nil {
+		t.Error("Load() wrote a backup")
+	}
+	warnings := manager.GetWarnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "version 0") || !strings.Contains(warnings[0], "config migrate "+userPath) {
+		t.Errorf("GetWarnings() = %v, want a warning suggesting config migrate", warnings)
+	}
+}
+
+func TestMigrateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	original := "# Team settings\nmax_branch_length: 50\n"
+	writeConfigFile(t, path, original)
+
+	backupPath, err := MigrateFile(path)
+	if err != nil {
+		t.Fatalf("MigrateFile() unexpected error: %v", err)
+	}
+	if backupPath != path+".v0.bak" {
+		t.Errorf("MigrateFile() backup = %q, want %q", backupPath, path+".v0.bak")
+	}
+	if backup, err := os.ReadFile(backupPath); err != nil || string(backup) != original {
+		t.Errorf("backup = %q, %v; want original content", backup, err)
+	}
+
+	upgraded, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read upgraded config: %v", err)
+	}
+	if !strings.HasPrefix(string(upgraded), "version: 1\n") || !strings.Contains(string(upgraded), "# Team settings") {
+		t.Errorf("upgraded config =\n%s", upgraded)
+	}
+
+	// A current file is left alone
+	if backupPath, err := MigrateFile(path); err != nil || backupPath != "" {
+		t.Errorf("MigrateFile() of a current file = %q, %v; want nothing done", backupPath, err)
+	}
+	if after, _ := os.ReadFile(path); string(after) != string(upgraded) {
+		t.Error("MigrateFile() rewrote an up-to-date config")
+	}
+
+	if _, err := MigrateFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("MigrateFile() expected error for a missing file")
+	}
+}
+
+func TestFileConfigManager_Load_NewerVersion(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "jiraflow.yaml")
+	writeConfigFile(t, userPath, "version: 99\nmax_branch_length: 50\n")
+
+	manager := &FileConfigManager{configPath: userPath}
+	if _, err := manager.Load(); err == nil {
+		t.Error("Load() expected error for config from a newer version")
+	}
+}
+
+func TestFileConfigManager_Load_RepoLayerMigratedInMemory(t *testing.T) {
+	tempDir := t.TempDir()
+	userPath := filepath.Join(tempDir, "jiraflow.yaml")
+	repoRoot := filepath.Join(tempDir, "repo")
+	repoPath := filepath.Join(repoRoot, RepoConfigFileName)
+	writeConfigFile(t, repoPath, "max_branch_length: 40\n")
+
+	manager := &FileConfigManager{configPath: userPath}
+	manager.SetRepoRoot(repoRoot)
+	if _, err := manager.Load(); err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	data, _ := os.ReadFile(repoPath)
+	if string(data) != "max_branch_length: 40\n" {
+		t.Errorf("Load() modified repository config: %q", data)
+	}
+
+	found := false
+	for _, warning := range manager.GetWarnings() {
+		found = found || strings.HasPrefix(warning, repoPath+": written for configuration version 0")
+	}
+	if !found {
+		t.Errorf("GetWarnings() = %v, want a warning about the outdated repository config", manager.GetWarnings())
+	}
+}
@@ -1,6 +1,7 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
 	"os"
 	"strings"
@@ -44,23 +45,28 @@ func (h *ErrorHandler) HandleError(err error) int {
 		return 0
 	}
 
-	// Check if it's a JiraFlowError
-	if jfErr, ok := err.(JiraFlowError); ok {
-		return h.handleJiraFlowError(jfErr)
+	// A cancelled operation is not a failure
+	if stderrors.Is(err, ErrCancelled) {
+		return 0
 	}
 
-	// Handle standard errors
-	fmt.Fprintf(os.Stderr, "%s\n", h.cliErrorStyle.Render("Error: "+err.Error()))
-	return 1
-}
+	// Errors already shown, e.g. in a JSON document, only pick the exit code
+	if IsReported(err) {
+		return ExitCode(err)
+	}
+
+	// The message includes the context added by wrapping; a JiraFlowError in the chain
+	// only adds suggestions and picks the exit code
+	jfErr, ok := AsJiraFlowError(err)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "%s\n", h.cliErrorStyle.Render("Error: "+err.Error()))
+		return 1
+	}
+
+	fmt.Fprintf(os.Stderr, "%s\n", h.cliErrorStyle.Render("❌ "+err.Error()))
 
-// handleJiraFlowError handles JiraFlowError types with appropriate exit codes
-func (h *ErrorHandler) handleJiraFlowError(err JiraFlowError) int {
-	// Display user-friendly error message
-	fmt.Fprintf(os.Stderr, "%s\n", h.cliErrorStyle.Render("❌ "+err.UserMessage()))
-	
 	// Display suggestions if available
-	suggestions := err.Suggestions()
+	suggestions := jfErr.Suggestions()
 	if len(suggestions) > 0 {
 		fmt.Fprintf(os.Stderr, "\n%s\n", h.warnStyle.Render("💡 Suggestions:"))
 		for _, suggestion := range suggestions {
@@ -68,8 +74,21 @@ func (h *ErrorHandler) handleJiraFlowError(err JiraFlowError) int {
 		}
 	}
 
-	// Return appropriate exit code based on error type
-	switch err.Type() {
+	return ExitCode(err)
+}
+
+// ExitCode returns the process exit code for an error based on its type
+func ExitCode(err error) int {
+	if err == nil || stderrors.Is(err, ErrCancelled) {
+		return 0
+	}
+
+	jfErr, ok := AsJiraFlowError(err)
+	if !ok {
+		return 1
+	}
+
+	switch jfErr.Type() {
 	case ErrorTypeConfig:
 		return 2
 	case ErrorTypeGit:
@@ -78,11 +97,40 @@ func (h *ErrorHandler) handleJiraFlowError(err JiraFlowError) int {
 		return 4
 	case ErrorTypeTUI:
 		return 5
+	case ErrorTypeUsage:
+		return 6
 	default:
 		return 1
 	}
 }
 
+// ErrorReport is the machine-readable form of an error, written by --output json
+type ErrorReport struct {
+	Type        string   `json:"type"`
+	Message     string   `json:"message"`
+	Suggestions []string `json:"suggestions,omitempty"`
+	ExitCode    int      `json:"exit_code"`
+}
+
+// NewErrorReport describes an error for machine-readable output
+func NewErrorReport(err error) *ErrorReport {
+	if err == nil {
+		return nil
+	}
+
+	report := &ErrorReport{
+		Type:     strings.ToLower(ErrorTypeGeneral.String()),
+		Message:  err.Error(),
+		ExitCode: ExitCode(err),
+	}
+	if jfErr, ok := AsJiraFlowError(err); ok {
+		report.Type = strings.ToLower(jfErr.Type().String())
+		report.Message = jfErr.UserMessage()
+		report.Suggestions = jfErr.Suggestions()
+	}
+	return report
+}
+
 // FormatErrorForTUI formats an error for display within the TUI
 func (h *ErrorHandler) FormatErrorForTUI(err error) string {
 	if err == nil {
@@ -92,7 +140,7 @@ func (h *ErrorHandler) FormatErrorForTUI(err error) string {
 	var content strings.Builder
 	
 	// Check if it's a JiraFlowError
-	if jfErr, ok := err.(JiraFlowError); ok {
+	if jfErr, ok := AsJiraFlowError(err); ok {
 		// Error title with icon
 		title := fmt.Sprintf("❌ %s Error", jfErr.Type().String())
 		titleStyle := h.tuiErrorStyle
@@ -148,7 +196,7 @@ func WrapError(err error, errorType ErrorType, recoverable bool) JiraFlowError {
 	}
 
 	// If it's already a JiraFlowError, return as-is
-	if jfErr, ok := err.(JiraFlowError); ok {
+	if jfErr, ok := AsJiraFlowError(err); ok {
 		return jfErr
 	}
 
@@ -167,9 +215,43 @@ func WrapError(err error, errorType ErrorType, recoverable bool) JiraFlowError {
 	}
 }
 
+// AsJiraFlowError finds the first JiraFlowError in the chain of wrapped errors
+// Both the error types and pointers to them are found
+func AsJiraFlowError(err error) (JiraFlowError, bool) {
+	var jfErr JiraFlowError
+	if stderrors.As(err, &jfErr) {
+		return jfErr, true
+	}
+	return nil, false
+}
+
+// asJiraError finds a JiraError in the chain of wrapped errors
+func asJiraError(err error) (*JiraError, bool) {
+	jfErr, _ := AsJiraFlowError(err)
+	switch e := jfErr.(type) {
+	case *JiraError:
+		return e, true
+	case JiraError:
+		return &e, true
+	}
+	return nil, false
+}
+
+// asGitError finds a GitError in the chain of wrapped errors
+func asGitError(err error) (*GitError, bool) {
+	jfErr, _ := AsJiraFlowError(err)
+	switch e := jfErr.(type) {
+	case *GitError:
+		return e, true
+	case GitError:
+		return &e, true
+	}
+	return nil, false
+}
+
 // IsRecoverableError checks if an error is recoverable
 func IsRecoverableError(err error) bool {
-	if jfErr, ok := err.(JiraFlowError); ok {
+	if jfErr, ok := AsJiraFlowError(err); ok {
 		return jfErr.IsRecoverable()
 	}
 	return false
@@ -177,7 +259,7 @@ func IsRecoverableError(err error) bool {
 
 // GetErrorType returns the error type if it's a JiraFlowError
 func GetErrorType(err error) ErrorType {
-	if jfErr, ok := err.(JiraFlowError); ok {
+	if jfErr, ok := AsJiraFlowError(err); ok {
 		return jfErr.Type()
 	}
 	return ErrorTypeGeneral
@@ -197,7 +279,7 @@ func NewDegradationHandler() *DegradationHandler {
 
 // HandleJiraDegradation handles graceful degradation when Jira CLI is unavailable
 func (d *DegradationHandler) HandleJiraDegradation(err error) string {
-	if jiraErr, ok := err.(*JiraError); ok {
+	if jiraErr, ok := asJiraError(err); ok {
 		if strings.Contains(jiraErr.Message, "not found") {
 			return d.errorHandler.FormatWarningForTUI(
 				"Jira CLI not available. You can still create branches by entering titles manually.",
@@ -216,7 +298,7 @@ func (d *DegradationHandler) HandleJiraDegradation(err error) string {
 
 // HandleGitDegradation handles graceful degradation for Git issues
 func (d *DegradationHandler) HandleGitDegradation(err error) string {
-	if gitErr, ok := err.(*GitError); ok {
+	if gitErr, ok := asGitError(err); ok {
 		if strings.Contains(gitErr.Message, "not a git repository") {
 			return d.errorHandler.FormatErrorForTUI(err)
 		}
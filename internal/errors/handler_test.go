@@ -2,6 +2,9 @@ package errors
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"os"
 	"strings"
 	"testing"
 )
@@ -287,4 +290,104 @@ func TestDegradationHandler(t *testing.T) {
 			t.Errorf("HandleConfigDegradation() = %v, want to contain 'Configuration' or 'default'", result)
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestNewErrorReport(t *testing.T) {
+	if report := NewErrorReport(nil); report != nil {
+		t.Errorf("NewErrorReport(nil) = %+v, want nil", report)
+	}
+
+	report := NewErrorReport(NewGitError("branch", "branch exists", true))
+	if report.Type != "git" || report.ExitCode != 3 || report.Message != "Branch operation failed: branch exists" || len(report.Suggestions) == 0 {
+		t.Errorf("NewErrorReport(GitError) = %+v", report)
+	}
+
+	report = NewErrorReport(errors.New("plain failure"))
+	if report.Type != "general" || report.ExitCode != 1 || report.Message != "plain failure" || report.Suggestions != nil {
+		t.Errorf("NewErrorReport(error) = %+v", report)
+	}
+}
+
+func TestExitCode_WrappedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"wrapped config error", fmt.Errorf("failed to load configuration: %w", NewConfigError("", nil, "bad", true)), 2},
+		{"wrapped git error", fmt.Errorf("failed to create branch: %w", NewGitError("branch", "failed", true)), 3},
+		{"git error value", GitError{Operation: "checkout", Message: "failed"}, 3},
+		{"doubly wrapped jira error", fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", NewJiraError("PROJ-1", "failed", true))), 4},
+		{"wrapped tui error", fmt.Errorf("TUI application failed: %w", NewTUIError("program", "failed", false)), 5},
+		{"cancelled", ErrCancelled, 0},
+		{"wrapped cancelled", fmt.Errorf("picker: %w", ErrCancelled), 0},
+		{"plain error", errors.New("plain"), 1},
+		{"usage error", NewUsageError("jiraflow lint", "invalid format 'xml'"), 6},
+		{"reported git error", MarkReported(fmt.Errorf("failed: %w", NewGitError("branch", "failed", true))), 3},
+	}
+
+	handler := NewErrorHandler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.want)
+			}
+			if got := handler.HandleError(tt.err); got != tt.want {
+				t.Errorf("HandleError() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// captureStderr returns what fn writes to standard error
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = writer
+	defer func() { os.Stderr = stderr }()
+
+	fn()
+	writer.Close()
+	output, _ := io.ReadAll(reader)
+	return string(output)
+}
+
+func TestErrorHandler_HandleError_Output(t *testing.T) {
+	handler := NewErrorHandler()
+
+	wrapped := fmt.Errorf("failed to create branch 'feature/PROJ-1': %w", NewGitError("branch", "already exists", true))
+	output := captureStderr(t, func() { handler.HandleError(wrapped) })
+	if strings.Count(output, "failed to create branch 'feature/PROJ-1'") != 1 {
+		t.Errorf("HandleError() output = %q, want the wrapped message once", output)
+	}
+
+	usage := NewUsageError("jiraflow lint", "--all cannot be combined with branch names")
+	output = captureStderr(t, func() { handler.HandleError(usage) })
+	if strings.Count(output, "--all cannot be combined") != 1 || !strings.Contains(output, "jiraflow lint --help") {
+		t.Errorf("HandleError() output = %q, want the message once and a --help suggestion", output)
+	}
+
+	output = captureStderr(t, func() { handler.HandleError(MarkReported(usage)) })
+	if output != "" {
+		t.Errorf("HandleError() output for a reported error = %q, want none", output)
+	}
+}
+
+func TestDegradationHandler_WrappedErrors(t *testing.T) {
+	handler := NewDegradationHandler()
+
+	wrapped := fmt.Errorf("fetch failed: %w", NewJiraError("PROJ-1", "jira CLI not found", true))
+	if got := handler.HandleJiraDegradation(wrapped); !strings.Contains(got, "Jira CLI not available") {
+		t.Errorf("HandleJiraDegradation(wrapped) = %q", got)
+	}
+
+	value := JiraError{TicketID: "PROJ-1", Message: "authentication failed"}
+	if got := handler.HandleJiraDegradation(value); !strings.Contains(got, "authentication failed") {
+		t.Errorf("HandleJiraDegradation(value) = %q", got)
+	}
+}
@@ -1,10 +1,15 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
 	"strings"
 )
 
+// ErrCancelled is returned when the user cancels an interactive operation
+// It is not reported as an error and exits with status 0
+var ErrCancelled = stderrors.New("user cancelled operation")
+
 // ErrorType represents the category of error
 type ErrorType int
 
@@ -14,6 +19,7 @@ const (
 	ErrorTypeJira
 	ErrorTypeTUI
 	ErrorTypeGeneral
+	ErrorTypeUsage
 )
 
 // String returns the string representation of ErrorType
@@ -29,6 +35,8 @@ func (et ErrorType) String() string {
 		return "Interface"
 	case ErrorTypeGeneral:
 		return "General"
+	case ErrorTypeUsage:
+		return "Usage"
 	default:
 		return "Unknown"
 	}
@@ -120,11 +128,12 @@ func (e GitError) Type() ErrorType {
 }
 
 func (e GitError) UserMessage() string {
+	if strings.Contains(strings.ToLower(e.Message), "not a git repository") {
+		return "This directory is not a Git repository"
+	}
+
 	switch e.Operation {
 	case "branch":
-		if strings.Contains(e.Message, "not a git repository") {
-			return "This directory is not a Git repository"
-		}
 		if strings.Contains(e.Message, "already exists") {
 			return "A branch with this name already exists"
 		}
@@ -139,12 +148,15 @@ func (e GitError) UserMessage() string {
 func (e GitError) Suggestions() []string {
 	suggestions := []string{}
 	
+	if strings.Contains(strings.ToLower(e.Message), "not a git repository") {
+		suggestions = append(suggestions, "Navigate to a Git repository directory")
+		suggestions = append(suggestions, "Initialize a Git repository with 'git init'")
+		return suggestions
+	}
+
 	switch e.Operation {
 	case "branch":
-		if strings.Contains(e.Message, "not a git repository") {
-			suggestions = append(suggestions, "Navigate to a Git repository directory")
-			suggestions = append(suggestions, "Initialize a Git repository with 'git init'")
-		} else if strings.Contains(e.Message, "already exists") {
+		if strings.Contains(e.Message, "already exists") {
 			suggestions = append(suggestions, "Use a different ticket number or title")
 			suggestions = append(suggestions, "Delete the existing branch if it's no longer needed")
 		} else if strings.Contains(e.Message, "does not exist") {
@@ -269,7 +281,10 @@ func (e TUIError) UserMessage() string {
 func (e TUIError) Suggestions() []string {
 	suggestions := []string{}
 	
-	if strings.Contains(e.Message, "terminal") {
+	if strings.Contains(e.Message, "redirected") {
+		suggestions = append(suggestions, "Provide --type and --ticket to use non-interactive mode")
+		suggestions = append(suggestions, "Pipe a \"KEY title\" line into 'jiraflow -'")
+	} else if strings.Contains(e.Message, "terminal") {
 		suggestions = append(suggestions, "Ensure your terminal supports the required features")
 		suggestions = append(suggestions, "Try resizing your terminal window")
 	} else {
@@ -328,4 +343,64 @@ func NewGeneralError(message string, recoverable bool) *GeneralError {
 		Message:     message,
 		Recoverable: recoverable,
 	}
-}
\ No newline at end of file
+}
+// UsageError represents invalid flags or arguments on the command line
+type UsageError struct {
+	// Command is the command path, e.g. "jiraflow config set"
+	Command string
+	Message string
+}
+
+func (e UsageError) Error() string {
+	return e.Message
+}
+
+func (e UsageError) Type() ErrorType {
+	return ErrorTypeUsage
+}
+
+func (e UsageError) UserMessage() string {
+	return e.Message
+}
+
+func (e UsageError) Suggestions() []string {
+	if e.Command == "" {
+		return nil
+	}
+	return []string{fmt.Sprintf("Run '%s --help' for usage", e.Command)}
+}
+
+func (e UsageError) IsRecoverable() bool {
+	return true
+}
+
+// NewUsageError creates a new UsageError
+func NewUsageError(command, message string) *UsageError {
+	return &UsageError{
+		Command: command,
+		Message: message,
+	}
+}
+
+// reportedError is an error that was already shown, e.g. in a JSON document
+type reportedError struct {
+	error
+}
+
+func (e reportedError) Unwrap() error {
+	return e.error
+}
+
+// MarkReported marks an error as already shown, so that only its exit code is used
+func MarkReported(err error) error {
+	if err == nil {
+		return nil
+	}
+	return reportedError{err}
+}
+
+// IsReported checks whether an error was marked as already shown
+func IsReported(err error) bool {
+	var reported reportedError
+	return stderrors.As(err, &reported)
+}
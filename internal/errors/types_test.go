@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -188,6 +189,11 @@ func TestTUIError(t *testing.T) {
 	if len(suggestions) == 0 {
 		t.Error("TUIError.Suggestions() returned empty slice")
 	}
+
+	redirected := NewTUIError("terminal", "standard input or output is redirected", false)
+	if suggestions := redirected.Suggestions(); len(suggestions) == 0 || !strings.Contains(suggestions[0], "non-interactive") {
+		t.Errorf("TUIError.Suggestions() for redirected output = %v, want non-interactive mode hint", suggestions)
+	}
 }
 
 func TestGeneralError(t *testing.T) {
@@ -13,7 +13,7 @@ type BranchInfo struct {
 	IsRemote  bool
 }
 
-// GetBranchesWithInfo returns detailed information about all branches
+// GetBranchesWithInfo returns detailed information about all local branches
 func (g *LocalGitRepository) GetBranchesWithInfo() ([]BranchInfo, error) {
 	if !g.IsGitRepository() {
 		return nil, GitError{
@@ -22,7 +22,10 @@ func (g *LocalGitRepository) GetBranchesWithInfo() ([]BranchInfo, error) {
 		}
 	}
 
-	cmd := exec.Command("git", "branch", "-a", "--format=%(refname:short)|%(HEAD)")
+	// Only list local branches; remote tracking branches are listed by GetRemoteBranches.
+	// Local branch names may contain slashes (feature/x), so they cannot be told apart
+	// from remote branches by name alone.
+	cmd := exec.Command("git", "branch", "--format=%(refname:short)|%(HEAD)")
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, GitError{
@@ -31,7 +34,12 @@ func (g *LocalGitRepository) GetBranchesWithInfo() ([]BranchInfo, error) {
 		}
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	return parseBranchesWithInfo(string(output)), nil
+}
+
+// parseBranchesWithInfo parses "name|HEAD" lines produced by git branch --format
+func parseBranchesWithInfo(output string) []BranchInfo {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
 	var branches []BranchInfo
 
 	for _, line := range lines {
@@ -45,21 +53,59 @@ func (g *LocalGitRepository) GetBranchesWithInfo() ([]BranchInfo, error) {
 			continue
 		}
 
-		branchName := parts[0]
-		isCurrent := parts[1] == "*"
-		isRemote := strings.HasPrefix(branchName, "origin/") || strings.Contains(branchName, "/")
-
-		// Skip remote tracking branches for local branch listing
-		if !isRemote {
-			branches = append(branches, BranchInfo{
-				Name:      branchName,
-				IsCurrent: isCurrent,
-				IsRemote:  isRemote,
-			})
+		branches = append(branches, BranchInfo{
+			Name:      parts[0],
+			IsCurrent: parts[1] == "*",
+		})
+	}
+
+	return branches
+}
+
+// GetRemoteBranches returns the remote tracking branches (e.g. origin/feature/x)
+func (g *LocalGitRepository) GetRemoteBranches() ([]string, error) {
+	if !g.IsGitRepository() {
+		return nil, GitError{
+			Operation: "branch",
+			Message:   "not a git repository",
+		}
+	}
+
+	cmd := exec.Command("git", "branch", "-r", "--format=%(refname:short)")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, GitError{
+			Operation: "branch",
+			Message:   "failed to list remote branches: " + err.Error(),
+		}
+	}
+
+	return parseRemoteBranches(string(output)), nil
+}
+
+// parseRemoteBranches parses remote branch names, skipping symbolic HEAD entries
+func parseRemoteBranches(output string) []string {
+	var branches []string
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		// Symbolic refs show up as "origin/HEAD" or just "origin" depending on the Git version
+		if line == "" || !strings.Contains(line, "/") || strings.HasSuffix(line, "/HEAD") {
+			continue
 		}
+		branches = append(branches, line)
 	}
 
-	return branches, nil
+	return branches
+}
+
+// SplitRemoteBranch splits a remote tracking branch into remote name and branch name
+func SplitRemoteBranch(name string) (string, string) {
+	remote, branch, found := strings.Cut(name, "/")
+	if !found {
+		return "", name
+	}
+	return remote, branch
 }
 
 // BranchSearchResult represents the result of a branch search operation
@@ -2,6 +2,9 @@ package git
 
 import (
 	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -1043,4 +1046,394 @@ func TestGitError(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+// initTestRepo creates a temporary Git repository with an initial commit on main
+// and changes into it for the duration of the test
+func initTestRepo(t *testing.T) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("Skipping test: git not installed")
+	}
+
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	runGit(t, "init", "-q", "-b", "main")
+	runGit(t, "config", "user.email", "test@example.com")
+	runGit(t, "config", "user.name", "Test")
+	runGit(t, "config", "commit.gpgsign", "false")
+	runGit(t, "commit", "-q", "--allow-empty", "-m", "initial")
+}
+
+// runGit runs a git command in the current directory and fails the test on error
+func runGit(t *testing.T, args ...string) string {
+	t.Helper()
+
+	output, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+func TestLocalGitRepository_BranchBaseAndAheadBehind(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+
+	if err := repo.CreateBranch("feature/PROJ-1-test", "main"); err != nil {
+		t.Fatalf("CreateBranch() unexpected error: %v", err)
+	}
+
+	base, err := repo.GetBranchBase("feature/PROJ-1-test")
+	if err != nil {
+		t.Fatalf("GetBranchBase() unexpected error: %v", err)
+	}
+	if base != "main" {
+		t.Errorf("GetBranchBase() = %q, want %q", base, "main")
+	}
+
+	if _, err := repo.GetBranchBase("main"); err == nil {
+		t.Error("GetBranchBase() expected error for branch without recorded base")
+	}
+
+	runGit(t, "commit", "-q", "--allow-empty", "-m", "one")
+	runGit(t, "commit", "-q", "--allow-empty", "-m", "two")
+	runGit(t, "checkout", "-q", "main")
+	runGit(t, "commit", "-q", "--allow-empty", "-m", "three")
+
+	ahead, behind, err := repo.GetAheadBehind("feature/PROJ-1-test", "main")
+	if err != nil {
+		t.Fatalf("GetAheadBehind() unexpected error: %v", err)
+	}
+	if ahead != 2 || behind != 1 {
+		t.Errorf("GetAheadBehind() = (%d, %d), want (2, 1)", ahead, behind)
+	}
+}
+
+func TestParseLeftRightCount(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		wantAhead   int
+		wantBehind  int
+		expectError bool
+	}{
+		{name: "tab separated", output: "1\t3\n", wantAhead: 3, wantBehind: 1},
+		{name: "up to date", output: "0\t0", wantAhead: 0, wantBehind: 0},
+		{name: "garbage", output: "fatal", expectError: true},
+		{name: "non numeric", output: "a\tb", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ahead, behind, err := parseLeftRightCount(tt.output)
+			if tt.expectError {
+				if err == nil {
+					t.Error("parseLeftRightCount() expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseLeftRightCount() unexpected error: %v", err)
+			}
+			if ahead != tt.wantAhead || behind != tt.wantBehind {
+				t.Errorf("parseLeftRightCount() = (%d, %d), want (%d, %d)", ahead, behind, tt.wantAhead, tt.wantBehind)
+			}
+		})
+	}
+}
+
+func TestLocalGitRepository_GetBranchesWithInfo_SlashedBranches(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+
+	runGit(t, "branch", "feature/PROJ-1-test")
+
+	branches, err := repo.GetBranchesWithInfo()
+	if err != nil {
+		t.Fatalf("GetBranchesWithInfo() unexpected error: %v", err)
+	}
+
+	names := make(map[string]BranchInfo)
+	for _, info := range branches {
+		names[info.Name] = info
+	}
+
+	if info, ok := names["feature/PROJ-1-test"]; !ok || info.IsRemote || info.IsCurrent {
+		t.Errorf("GetBranchesWithInfo() = %+v, want local non-current feature/PROJ-1-test", branches)
+	}
+	if info, ok := names["main"]; !ok || !info.IsCurrent {
+		t.Errorf("GetBranchesWithInfo() = %+v, want current main", branches)
+	}
+}
+
+func TestParseRemoteBranches(t *testing.T) {
+	output := "origin\norigin/HEAD\norigin/main\norigin/feature/PROJ-1-test\n\nupstream/develop\n"
+
+	got := parseRemoteBranches(output)
+	want := []string{"origin/main", "origin/feature/PROJ-1-test", "upstream/develop"}
+
+	if len(got) != len(want) {
+		t.Fatalf("parseRemoteBranches() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("parseRemoteBranches()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSplitRemoteBranch(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantRemote string
+		wantBranch string
+	}{
+		{name: "simple", input: "origin/main", wantRemote: "origin", wantBranch: "main"},
+		{name: "nested branch", input: "origin/feature/PROJ-1-x", wantRemote: "origin", wantBranch: "feature/PROJ-1-x"},
+		{name: "no remote", input: "main", wantRemote: "", wantBranch: "main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote, branch := SplitRemoteBranch(tt.input)
+			if remote != tt.wantRemote || branch != tt.wantBranch {
+				t.Errorf("SplitRemoteBranch(%q) = (%q, %q), want (%q, %q)", tt.input, remote, branch, tt.wantRemote, tt.wantBranch)
+			}
+		})
+	}
+}
+
+func TestLocalGitRepository_GetRemoteURLs(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+
+	urls, err := repo.GetRemoteURLs()
+	if err != nil {
+		t.Fatalf("GetRemoteURLs() unexpected error: %v", err)
+	}
+	if len(urls) != 0 {
+		t.Errorf("GetRemoteURLs() = %v, want none", urls)
+	}
+
+	runGit(t, "remote", "add", "origin", "git@github.com:acme/app.git")
+	runGit(t, "remote", "add", "upstream", "https://github.com/oss/app.git")
+
+	urls, err = repo.GetRemoteURLs()
+	if err != nil {
+		t.Fatalf("GetRemoteURLs() unexpected error: %v", err)
+	}
+	want := []string{"git@github.com:acme/app.git", "https://github.com/oss/app.git"}
+	if !reflect.DeepEqual(urls, want) {
+		t.Errorf("GetRemoteURLs() = %v, want %v", urls, want)
+	}
+}
+
+func TestLocalGitRepository_ReadFileAtRef(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+
+	if err := os.MkdirAll(".jiraflow", 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(".jiraflow/team.yaml", []byte("max_branch_length: 40\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, "add", ".jiraflow/team.yaml")
+	runGit(t, "commit", "-q", "-m", "team config")
+
+	// Later working tree changes are not visible at the revision
+	if err := os.WriteFile(".jiraflow/team.yaml", []byte("max_branch_length: 80\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := repo.ReadFileAtRef("main", ".jiraflow/team.yaml")
+	if err != nil {
+		t.Fatalf("ReadFileAtRef() unexpected error: %v", err)
+	}
+	if string(data) != "max_branch_length: 40\n" {
+		t.Errorf("ReadFileAtRef() = %q, want committed content", data)
+	}
+
+	if _, err := repo.ReadFileAtRef("main", "missing.yaml"); err == nil {
+		t.Error("ReadFileAtRef() expected error for a missing file")
+	}
+	if _, err := repo.ReadFileAtRef("no-such-ref", ".jiraflow/team.yaml"); err == nil {
+		t.Error("ReadFileAtRef() expected error for an unknown revision")
+	}
+}
+
+func TestLocalGitRepository_GetHooksPath(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+
+	dir, err := repo.GetTopLevel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir("sub", 0750); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir("sub")
+
+	path, err := repo.GetHooksPath()
+	if err != nil {
+		t.Fatalf("GetHooksPath() unexpected error: %v", err)
+	}
+	if want := filepath.Join(dir, ".git", "hooks"); path != want {
+		t.Errorf("GetHooksPath() = %q, want %q", path, want)
+	}
+
+	// A relative core.hooksPath is relative to the repository root, not the current directory
+	runGit(t, "config", "core.hooksPath", ".githooks")
+	path, err = repo.GetHooksPath()
+	if err != nil {
+		t.Fatalf("GetHooksPath() unexpected error: %v", err)
+	}
+	if want := filepath.Join(dir, ".githooks"); path != want {
+		t.Errorf("GetHooksPath() with core.hooksPath = %q, want %q", path, want)
+	}
+}
+
+func TestLocalGitRepository_Commit(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+
+	staged, err := repo.HasStagedChanges()
+	if err != nil || staged {
+		t.Fatalf("HasStagedChanges() = %v, %v, want false", staged, err)
+	}
+
+	if err := os.WriteFile("file.txt", []byte("one\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, "add", "file.txt")
+	staged, err = repo.HasStagedChanges()
+	if err != nil || !staged {
+		t.Fatalf("HasStagedChanges() = %v, %v, want true", staged, err)
+	}
+
+	if err := repo.Commit("feat: add file\n\nRefs: PROJ-1\n", false); err != nil {
+		t.Fatalf("Commit() unexpected error: %v", err)
+	}
+	if got := runGit(t, "log", "-1", "--format=%B"); got != "feat: add file\n\nRefs: PROJ-1" {
+		t.Errorf("commit message = %q", got)
+	}
+
+	// With all set, modified tracked files are committed without staging
+	if err := os.WriteFile("file.txt", []byte("two\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Commit("change file", true); err != nil {
+		t.Fatalf("Commit(all) unexpected error: %v", err)
+	}
+	if got := runGit(t, "status", "--porcelain"); got != "" {
+		t.Errorf("status after Commit(all) = %q, want clean", got)
+	}
+
+	if err := repo.Commit("nothing to commit", false); err == nil {
+		t.Error("Commit() without changes: expected error")
+	}
+	if err := repo.Commit("  ", true); err == nil {
+		t.Error("Commit() with empty message: expected error")
+	}
+}
+
+func TestLocalGitRepository_CreateBranchWithoutCheckout(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+
+	if err := repo.CreateBranchWithoutCheckout("feature/PROJ-2-batch", "main"); err != nil {
+		t.Fatalf("CreateBranchWithoutCheckout() unexpected error: %v", err)
+	}
+	if current := runGit(t, "branch", "--show-current"); current != "main" {
+		t.Errorf("current branch = %q, want main", current)
+	}
+	if base, err := repo.GetBranchBase("feature/PROJ-2-batch"); err != nil || base != "main" {
+		t.Errorf("GetBranchBase() = %q, %v, want main", base, err)
+	}
+
+	if err := repo.CreateBranchWithoutCheckout("feature/PROJ-2-batch", "main"); err == nil {
+		t.Error("CreateBranchWithoutCheckout() for existing branch: expected error")
+	}
+	if err := repo.CreateBranchWithoutCheckout("feature/PROJ-3", "missing"); err == nil {
+		t.Error("CreateBranchWithoutCheckout() from missing base: expected error")
+	}
+}
+
+func TestLocalGitRepository_UndoOperations(t *testing.T) {
+	initTestRepo(t)
+	repo := NewLocalGitRepository()
+	head := runGit(t, "rev-parse", "HEAD")
+
+	if got, err := repo.ResolveRef("main"); err != nil || got != head {
+		t.Errorf("ResolveRef(main) = %q, %v, want %q", got, err, head)
+	}
+	if _, err := repo.ResolveRef("missing"); err == nil {
+		t.Error("ResolveRef(missing) expected error but got none")
+	}
+
+	path, err := repo.GetGitPath("jiraflow-undo.json")
+	if err != nil {
+		t.Fatalf("GetGitPath() unexpected error: %v", err)
+	}
+	if !filepath.IsAbs(path) || filepath.Base(filepath.Dir(path)) != ".git" {
+		t.Errorf("GetGitPath() = %q, want a file in .git", path)
+	}
+
+	// Stash a change and bring it back
+	if err := os.WriteFile("file.txt", []byte("one\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, "add", "file.txt")
+	runGit(t, "commit", "-q", "-m", "add file")
+	if err := os.WriteFile("file.txt", []byte("two\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dirty, err := repo.HasUncommittedChanges()
+	if err != nil || !dirty {
+		t.Fatalf("HasUncommittedChanges() = %v, %v, want true", dirty, err)
+	}
+	stash, err := repo.Stash("test")
+	if err != nil {
+		t.Fatalf("Stash() unexpected error: %v", err)
+	}
+	if dirty, _ := repo.HasUncommittedChanges(); dirty {
+		t.Error("HasUncommittedChanges() = true after Stash(), want false")
+	}
+	if err := repo.PopStash(stash); err != nil {
+		t.Fatalf("PopStash() unexpected error: %v", err)
+	}
+	if content, _ := os.ReadFile("file.txt"); string(content) != "two\n" {
+		t.Errorf("file content after PopStash() = %q, want %q", content, "two\n")
+	}
+	if list := runGit(t, "stash", "list"); list != "" {
+		t.Errorf("stash list after PopStash() = %q, want empty", list)
+	}
+
+	// Upstream configuration and branch deletion
+	runGit(t, "branch", "feature/PROJ-1")
+	if _, _, err := repo.GetUpstream("feature/PROJ-1"); err == nil {
+		t.Error("GetUpstream() expected error for a branch without upstream")
+	}
+	runGit(t, "config", "branch.feature/PROJ-1.remote", "origin")
+	runGit(t, "config", "branch.feature/PROJ-1.merge", "refs/heads/feature/PROJ-1")
+	remote, name, err := repo.GetUpstream("feature/PROJ-1")
+	if err != nil || remote != "origin" || name != "feature/PROJ-1" {
+		t.Errorf("GetUpstream() = %q, %q, %v, want origin feature/PROJ-1", remote, name, err)
+	}
+
+	if err := repo.DeleteBranch("feature/PROJ-1"); err != nil {
+		t.Fatalf("DeleteBranch() unexpected error: %v", err)
+	}
+	if _, err := repo.ResolveRef("refs/heads/feature/PROJ-1"); err == nil {
+		t.Error("branch still exists after DeleteBranch()")
+	}
+	if err := repo.DeleteBranch("feature/PROJ-1"); err == nil {
+		t.Error("DeleteBranch() of a missing branch expected error but got none")
+	}
+}
@@ -1,7 +1,11 @@
 package git
 
 import (
+	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"jiraflow/internal/errors"
@@ -11,13 +15,34 @@ import (
 type GitRepository interface {
 	GetLocalBranches() ([]string, error)
 	GetBranchesWithInfo() ([]BranchInfo, error)
+	GetRemoteBranches() ([]string, error)
 	GetCurrentBranch() (string, error)
 	CreateBranch(name, baseBranch string) error
+	CreateBranchWithoutCheckout(name, baseBranch string) error
 	CheckoutBranch(name string) error
+	HasStagedChanges() (bool, error)
+	Commit(message string, all bool) error
 	IsGitRepository() bool
+	GetTopLevel() (string, error)
+	GetHooksPath() (string, error)
+	GetRemoteURLs() ([]string, error)
+	ReadFileAtRef(ref, path string) ([]byte, error)
 	SearchBranches(searchTerm string) (BranchSearchResult, error)
+	GetBranchBase(name string) (string, error)
+	GetAheadBehind(name, base string) (int, int, error)
+	GetGitPath(name string) (string, error)
+	ResolveRef(ref string) (string, error)
+	DeleteBranch(name string) error
+	HasUncommittedChanges() (bool, error)
+	Stash(message string) (string, error)
+	PopStash(ref string) error
+	GetUpstream(name string) (string, string, error)
+	DeleteRemoteBranch(remote, name string) error
 }
 
+// baseConfigKey is the per-branch git config key used to remember the base branch
+const baseConfigKey = "jiraflow-base"
+
 // GitError is an alias for the centralized GitError type
 type GitError = errors.GitError
 
@@ -36,6 +61,90 @@ func (g *LocalGitRepository) IsGitRepository() bool {
 	return err == nil
 }
 
+// GetTopLevel returns the absolute path of the repository's working tree root
+func (g *LocalGitRepository) GetTopLevel() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", errors.NewGitError("rev-parse", "failed to determine repository root: "+err.Error(), false)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetHooksPath returns the absolute path of the hooks directory, honoring core.hooksPath
+func (g *LocalGitRepository) GetHooksPath() (string, error) {
+	path, err := g.GetGitPath("hooks")
+	if err != nil {
+		return "", errors.NewGitError("rev-parse", "failed to determine hooks directory: "+err.Error(), false)
+	}
+	return path, nil
+}
+
+// GetGitPath returns the absolute path of a file in the Git directory, e.g. "hooks"
+// Configuration that relocates the file, such as core.hooksPath, is honored
+func (g *LocalGitRepository) GetGitPath(name string) (string, error) {
+	topLevel, err := g.GetTopLevel()
+	if err != nil {
+		return "", err
+	}
+
+	// Relative results, including a relative core.hooksPath, are relative to the repository root
+	cmd := exec.Command("git", "rev-parse", "--git-path", name)
+	cmd.Dir = topLevel
+	output, err := cmd.Output()
+	if err != nil {
+		return "", errors.NewGitError("rev-parse", "failed to determine path of '"+name+"': "+err.Error(), false)
+	}
+
+	path := strings.TrimSpace(string(output))
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(topLevel, path)
+	}
+	return path, nil
+}
+
+// GetRemoteURLs returns the fetch URLs of all configured remotes
+func (g *LocalGitRepository) GetRemoteURLs() ([]string, error) {
+	cmd := exec.Command("git", "config", "--get-regexp", `^remote\..*\.url$`)
+	output, err := cmd.Output()
+	if err != nil {
+		// git config exits with 1 when no remote is configured
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			return []string{}, nil
+		}
+		return nil, errors.NewGitError("config", "failed to get remote URLs: "+err.Error(), false)
+	}
+
+	return parseRemoteURLs(string(output)), nil
+}
+
+// parseRemoteURLs parses `git config --get-regexp remote.*.url` output into URLs
+func parseRemoteURLs(output string) []string {
+	urls := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		_, url, found := strings.Cut(strings.TrimSpace(line), " ")
+		if found && url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// ReadFileAtRef returns the content of a file at a revision, with the path relative to the repository root
+func (g *LocalGitRepository) ReadFileAtRef(ref, path string) ([]byte, error) {
+	cmd := exec.Command("git", "cat-file", "blob", ref+":"+path)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, errors.NewGitError("cat-file", fmt.Sprintf("%s not found at %s: %s", path, ref, strings.TrimSpace(string(exitErr.Stderr))), false)
+		}
+		return nil, errors.NewGitError("cat-file", "failed to read file: "+err.Error(), false)
+	}
+
+	return output, nil
+}
+
 // GetLocalBranches returns a list of local Git branches
 func (g *LocalGitRepository) GetLocalBranches() ([]string, error) {
 	if !g.IsGitRepository() {
@@ -101,9 +210,39 @@ func (g *LocalGitRepository) CreateBranch(name, baseBranch string) error {
 		return errors.NewGitError("branch", "failed to create and checkout branch '"+name+"' from '"+baseBranch+"': "+err.Error(), true)
 	}
 
+	recordBranchBase(name, baseBranch)
 	return nil
 }
 
+// CreateBranchWithoutCheckout creates a new Git branch from the specified base branch
+// The current branch and working tree are left unchanged
+func (g *LocalGitRepository) CreateBranchWithoutCheckout(name, baseBranch string) error {
+	if !g.IsGitRepository() {
+		return errors.NewGitError("branch", "not a git repository", false)
+	}
+
+	if name == "" {
+		return errors.NewGitError("branch", "branch name cannot be empty", false)
+	}
+
+	if baseBranch == "" {
+		return errors.NewGitError("branch", "base branch cannot be empty", false)
+	}
+
+	cmd := exec.Command("git", "branch", name, baseBranch)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.NewGitError("branch", "failed to create branch '"+name+"' from '"+baseBranch+"': "+strings.TrimSpace(string(output)), true)
+	}
+
+	recordBranchBase(name, baseBranch)
+	return nil
+}
+
+// recordBranchBase remembers the base branch so that it can be recovered later (best effort)
+func recordBranchBase(name, baseBranch string) {
+	_ = exec.Command("git", "config", "branch."+name+"."+baseConfigKey, baseBranch).Run()
+}
+
 // CheckoutBranch switches to the specified Git branch
 func (g *LocalGitRepository) CheckoutBranch(name string) error {
 	if !g.IsGitRepository() {
@@ -122,6 +261,41 @@ func (g *LocalGitRepository) CheckoutBranch(name string) error {
 	return nil
 }
 
+// HasStagedChanges reports whether the index differs from HEAD
+func (g *LocalGitRepository) HasStagedChanges() (bool, error) {
+	cmd := exec.Command("git", "diff", "--cached", "--quiet")
+	err := cmd.Run()
+	if err == nil {
+		return false, nil
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+		return true, nil
+	}
+	return false, errors.NewGitError("diff", "failed to check for staged changes: "+err.Error(), true)
+}
+
+// Commit records the staged changes, or all tracked changes if all is set, with the given message
+// Output of git and its hooks is shown to the user
+func (g *LocalGitRepository) Commit(message string, all bool) error {
+	if strings.TrimSpace(message) == "" {
+		return errors.NewGitError("commit", "commit message cannot be empty", false)
+	}
+
+	args := []string{"commit", "--file", "-"}
+	if all {
+		args = append(args, "--all")
+	}
+	cmd := exec.Command("git", args...)
+	cmd.Stdin = strings.NewReader(message)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errors.NewGitError("commit", "failed to commit: "+err.Error(), true)
+	}
+
+	return nil
+}
+
 // SearchBranches searches for branches matching the given search term
 func (g *LocalGitRepository) SearchBranches(searchTerm string) (BranchSearchResult, error) {
 	branches, err := g.GetLocalBranches()
@@ -131,4 +305,152 @@ func (g *LocalGitRepository) SearchBranches(searchTerm string) (BranchSearchResu
 
 	result := FilterBranchesRealtime(branches, searchTerm)
 	return result, nil
-}
\ No newline at end of file
+}
+
+// GetBranchBase returns the base branch recorded when the branch was created by JiraFlow
+func (g *LocalGitRepository) GetBranchBase(name string) (string, error) {
+	if !g.IsGitRepository() {
+		return "", errors.NewGitError("config", "not a git repository", false)
+	}
+
+	if name == "" {
+		return "", errors.NewGitError("config", "branch name cannot be empty", false)
+	}
+
+	cmd := exec.Command("git", "config", "--get", "branch."+name+"."+baseConfigKey)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", errors.NewGitError("config", "no base branch recorded for '"+name+"'", true)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetAheadBehind returns how many commits the branch is ahead of and behind the base branch
+func (g *LocalGitRepository) GetAheadBehind(name, base string) (int, int, error) {
+	if !g.IsGitRepository() {
+		return 0, 0, errors.NewGitError("rev-list", "not a git repository", false)
+	}
+
+	if name == "" || base == "" {
+		return 0, 0, errors.NewGitError("rev-list", "branch names cannot be empty", false)
+	}
+
+	cmd := exec.Command("git", "rev-list", "--left-right", "--count", base+"..."+name)
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, 0, errors.NewGitError("rev-list", "failed to compare '"+name+"' with '"+base+"': "+err.Error(), true)
+	}
+
+	return parseLeftRightCount(string(output))
+}
+
+// parseLeftRightCount parses the output of git rev-list --left-right --count base...branch
+// The left count is the number of commits only on base (behind), the right count only on branch (ahead)
+func parseLeftRightCount(output string) (int, int, error) {
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return 0, 0, errors.NewGitError("rev-list", fmt.Sprintf("unexpected rev-list output %q", output), true)
+	}
+
+	behind, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, errors.NewGitError("rev-list", fmt.Sprintf("unexpected rev-list output %q", output), true)
+	}
+
+	ahead, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, errors.NewGitError("rev-list", fmt.Sprintf("unexpected rev-list output %q", output), true)
+	}
+
+	return ahead, behind, nil
+}
+
+// ResolveRef returns the commit a revision points to
+func (g *LocalGitRepository) ResolveRef(ref string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", errors.NewGitError("rev-parse", "'"+ref+"' does not exist", true)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// DeleteBranch deletes a local branch, whether or not it is merged, together with its configuration
+func (g *LocalGitRepository) DeleteBranch(name string) error {
+	if name == "" {
+		return errors.NewGitError("branch", "branch name cannot be empty", false)
+	}
+
+	cmd := exec.Command("git", "branch", "-D", name)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.NewGitError("branch", "failed to delete branch '"+name+"': "+strings.TrimSpace(string(output)), true)
+	}
+
+	return nil
+}
+
+// HasUncommittedChanges reports whether tracked files differ from HEAD
+func (g *LocalGitRepository) HasUncommittedChanges() (bool, error) {
+	cmd := exec.Command("git", "status", "--porcelain", "--untracked-files=no")
+	output, err := cmd.Output()
+	if err != nil {
+		return false, errors.NewGitError("status", "failed to check for uncommitted changes: "+err.Error(), true)
+	}
+
+	return strings.TrimSpace(string(output)) != "", nil
+}
+
+// Stash stashes the uncommitted changes of tracked files and returns the stash commit
+func (g *LocalGitRepository) Stash(message string) (string, error) {
+	cmd := exec.Command("git", "stash", "push", "--quiet", "--message", message)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", errors.NewGitError("stash", "failed to stash changes: "+strings.TrimSpace(string(output)), true)
+	}
+
+	return g.ResolveRef("refs/stash")
+}
+
+// PopStash applies the stash commit and drops it from the stash list if it is the latest entry
+func (g *LocalGitRepository) PopStash(ref string) error {
+	args := []string{"stash", "apply", "--quiet", ref}
+	if latest, err := g.ResolveRef("refs/stash"); err == nil && latest == ref {
+		args = []string{"stash", "pop", "--quiet"}
+	}
+
+	cmd := exec.Command("git", args...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.NewGitError("stash", "failed to apply stash "+ref+": "+strings.TrimSpace(string(output)), true)
+	}
+
+	return nil
+}
+
+// GetUpstream returns the remote and remote branch name a local branch tracks
+func (g *LocalGitRepository) GetUpstream(name string) (string, string, error) {
+	remote, err := exec.Command("git", "config", "--get", "branch."+name+".remote").Output()
+	if err != nil {
+		return "", "", errors.NewGitError("config", "branch '"+name+"' has no upstream", true)
+	}
+	merge, err := exec.Command("git", "config", "--get", "branch."+name+".merge").Output()
+	if err != nil {
+		return "", "", errors.NewGitError("config", "branch '"+name+"' has no upstream", true)
+	}
+
+	return strings.TrimSpace(string(remote)), strings.TrimPrefix(strings.TrimSpace(string(merge)), "refs/heads/"), nil
+}
+
+// DeleteRemoteBranch deletes a branch on a remote
+func (g *LocalGitRepository) DeleteRemoteBranch(remote, name string) error {
+	if remote == "" || name == "" {
+		return errors.NewGitError("push", "remote and branch name cannot be empty", false)
+	}
+
+	cmd := exec.Command("git", "push", "--quiet", remote, "--delete", name)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.NewGitError("push", "failed to delete '"+name+"' on '"+remote+"': "+strings.TrimSpace(string(output)), true)
+	}
+
+	return nil
+}
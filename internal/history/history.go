@@ -0,0 +1,199 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxEntries is the number of entries kept in the history, oldest entries are dropped first
+const maxEntries = 1000
+
+// trimAfter is the number of lines at which the history file is trimmed back to maxEntries,
+// so that it is only rewritten once in a while instead of on every add
+const trimAfter = maxEntries + maxEntries/2
+
+// Entry records a branch created by JiraFlow
+type Entry struct {
+	Time   time.Time `json:"time"`
+	Repo   string    `json:"repo"`
+	Type   string    `json:"type"`
+	Base   string    `json:"base"`
+	Ticket string    `json:"ticket"`
+	Title  string    `json:"title,omitempty"`
+	Branch string    `json:"branch"`
+}
+
+// Store keeps the history in a file with one JSON entry per line, oldest first
+type Store struct {
+	path string
+	now  func() time.Time
+}
+
+// NewStore creates a history store kept in the given file
+func NewStore(path string) *Store {
+	return &Store{path: path, now: time.Now}
+}
+
+// Path returns the file the history is kept in
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Load returns the last maxEntries entries, oldest first
+// A missing history file is an empty history; lines that cannot be parsed are skipped
+func (s *Store) Load() ([]Entry, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil && entry.Branch != "" {
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) > maxEntries {
+		entries = entries[len(entries)-maxEntries:]
+	}
+	return entries, scanner.Err()
+}
+
+// Add appends an entry, setting its time if it is not set
+// When the file grows well beyond the limit it is trimmed to the newest entries
+func (s *Store) Add(entry Entry) error {
+	if entry.Time.IsZero() {
+		entry.Time = s.now()
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0750); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil || bytes.Count(data, []byte{'\n'}) <= trimAfter {
+		return err
+	}
+	return s.trim()
+}
+
+// trim replaces the history file with its newest entries
+// The entries are written to a temporary file that is renamed over the history, so that
+// readers and concurrent writers never see a partially written file
+func (s *Store) trim() error {
+	entries, err := s.Load()
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		buffer.Write(line)
+		buffer.WriteByte('\n')
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(buffer.Bytes()); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), s.path)
+}
+
+// Filter selects history entries; empty fields match every entry
+type Filter struct {
+	// Repo matches entries whose repository path contains it
+	Repo string
+	// Ticket matches the ticket key exactly, ignoring case
+	Ticket string
+	// Type matches the branch type exactly
+	Type string
+	// Since matches entries created at or after this time
+	Since time.Time
+	// Limit is the maximum number of entries returned, 0 for all
+	Limit int
+}
+
+// Apply returns the matching entries, newest first
+func (f Filter) Apply(entries []Entry) []Entry {
+	var matched []Entry
+	for _, entry := range entries {
+		if f.Repo != "" && !strings.Contains(entry.Repo, f.Repo) {
+			continue
+		}
+		if f.Ticket != "" && !strings.EqualFold(entry.Ticket, f.Ticket) {
+			continue
+		}
+		if f.Type != "" && entry.Type != f.Type {
+			continue
+		}
+		if !f.Since.IsZero() && entry.Time.Before(f.Since) {
+			continue
+		}
+		matched = append(matched, entry)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].Time.After(matched[j].Time)
+	})
+	if f.Limit > 0 && len(matched) > f.Limit {
+		matched = matched[:f.Limit]
+	}
+	return matched
+}
+
+// RecentTickets returns the latest entry of each ticket, newest first, at most limit entries
+func RecentTickets(entries []Entry, limit int) []Entry {
+	var recent []Entry
+	seen := make(map[string]bool)
+	for _, entry := range (Filter{}).Apply(entries) {
+		if entry.Ticket == "" || seen[entry.Ticket] {
+			continue
+		}
+		seen[entry.Ticket] = true
+		recent = append(recent, entry)
+		if limit > 0 && len(recent) == limit {
+			break
+		}
+	}
+	return recent
+}
@@ -0,0 +1,165 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testEntries returns entries of two repositories, one hour apart, oldest first
+func testEntries() []Entry {
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	return []Entry{
+		{Time: start, Repo: "/src/api", Type: "feature", Base: "main", Ticket: "PROJ-1", Title: "Add login", Branch: "feature/PROJ-1-add-login"},
+		{Time: start.Add(time.Hour), Repo: "/src/web", Type: "bugfix", Base: "develop", Ticket: "PROJ-2", Branch: "bugfix/PROJ-2"},
+		{Time: start.Add(2 * time.Hour), Repo: "/src/web", Type: "feature", Base: "main", Ticket: "PROJ-1", Title: "Add login", Branch: "feature/PROJ-1-add-login"},
+	}
+}
+
+func TestStore_AddAndLoad(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "state", "history.jsonl"))
+	clock := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return clock }
+
+	if entries, err := store.Load(); err != nil || len(entries) != 0 {
+		t.Fatalf("Load() of missing history = %v, %v, want empty", entries, err)
+	}
+
+	for _, entry := range testEntries() {
+		if err := store.Add(entry); err != nil {
+			t.Fatalf("Add() unexpected error: %v", err)
+		}
+	}
+	if err := store.Add(Entry{Ticket: "PROJ-3", Branch: "feature/PROJ-3"}); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+
+	// Unreadable lines are skipped
+	file, err := os.OpenFile(store.Path(), os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = file.WriteString("not json\n")
+	file.Close()
+
+	entries, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("Load() = %d entries, want 4", len(entries))
+	}
+	if entries[0] != testEntries()[0] {
+		t.Errorf("first entry = %+v, want %+v", entries[0], testEntries()[0])
+	}
+	if !entries[3].Time.Equal(clock) {
+		t.Errorf("entry without time got time %v, want %v", entries[3].Time, clock)
+	}
+}
+
+func TestStore_AddDropsOldestEntries(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "history.jsonl"))
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= maxEntries; i++ {
+		if err := store.Add(Entry{Time: start.Add(time.Duration(i) * time.Minute), Branch: "b"}); err != nil {
+			t.Fatalf("Add() unexpected error: %v", err)
+		}
+	}
+
+	entries, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(entries) != maxEntries || !entries[0].Time.Equal(start.Add(time.Minute)) {
+		t.Errorf("Load() = %d entries starting at %v, want %d starting one minute later", len(entries), entries[0].Time, maxEntries)
+	}
+}
+
+func TestStore_AddTrimsFileWellPastLimit(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(filepath.Join(dir, "history.jsonl"))
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	add := func(i int) {
+		t.Helper()
+		if err := store.Add(Entry{Time: start.Add(time.Duration(i) * time.Minute), Branch: "b"}); err != nil {
+			t.Fatalf("Add() unexpected error: %v", err)
+		}
+	}
+	lines := func() int {
+		t.Helper()
+		data, err := os.ReadFile(store.Path())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.Count(string(data), "\n")
+	}
+
+	// Entries past the limit are appended, not rewritten
+	for i := 0; i < trimAfter; i++ {
+		add(i)
+	}
+	if got := lines(); got != trimAfter {
+		t.Fatalf("history file has %d lines, want %d before trimming", got, trimAfter)
+	}
+
+	add(trimAfter)
+	if got := lines(); got != maxEntries {
+		t.Errorf("history file has %d lines after trimming, want %d", got, maxEntries)
+	}
+	entries, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(entries) != maxEntries || !entries[maxEntries-1].Time.Equal(start.Add(trimAfter*time.Minute)) {
+		t.Errorf("Load() = %d entries, want the newest %d", len(entries), maxEntries)
+	}
+
+	// No temporary files are left behind
+	if files, _ := os.ReadDir(dir); len(files) != 1 {
+		t.Errorf("history directory has %d files, want only the history", len(files))
+	}
+}
+
+func TestFilter_Apply(t *testing.T) {
+	entries := testEntries()
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []int
+	}{
+		{"all, newest first", Filter{}, []int{2, 1, 0}},
+		{"repository", Filter{Repo: "web"}, []int{2, 1}},
+		{"ticket ignoring case", Filter{Ticket: "proj-1"}, []int{2, 0}},
+		{"type", Filter{Type: "bugfix"}, []int{1}},
+		{"since", Filter{Since: entries[1].Time}, []int{2, 1}},
+		{"limit", Filter{Limit: 1}, []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filter.Apply(entries)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Apply() = %d entries, want %d", len(got), len(tt.want))
+			}
+			for i, index := range tt.want {
+				if got[i] != entries[index] {
+					t.Errorf("entry %d = %+v, want %+v", i, got[i], entries[index])
+				}
+			}
+		})
+	}
+}
+
+func TestRecentTickets(t *testing.T) {
+	entries := testEntries()
+
+	recent := RecentTickets(entries, 0)
+	if len(recent) != 2 || recent[0] != entries[2] || recent[1] != entries[1] {
+		t.Errorf("RecentTickets() = %+v, want the latest PROJ-1 and PROJ-2 entries", recent)
+	}
+	if recent := RecentTickets(entries, 1); len(recent) != 1 || recent[0].Ticket != "PROJ-1" {
+		t.Errorf("RecentTickets(limit 1) = %+v, want PROJ-1 only", recent)
+	}
+}
@@ -0,0 +1,158 @@
+package hooks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Git hooks managed by JiraFlow
+const (
+	PrePush          = "pre-push"
+	PrepareCommitMsg = "prepare-commit-msg"
+	CommitMsg        = "commit-msg"
+)
+
+// Names lists the managed hooks in the order they are installed
+var Names = []string{PrePush, PrepareCommitMsg, CommitMsg}
+
+// marker identifies hook scripts written by JiraFlow
+const marker = "# jiraflow-managed hook"
+
+// ChainedSuffix is appended to an existing hook that is kept and run before the JiraFlow hook
+const ChainedSuffix = ".jiraflow-chained"
+
+// Result describes what happened to one hook
+type Result struct {
+	Hook    string
+	Path    string
+	Action  string // installed, updated, removed or not installed
+	Chained bool   // an existing hook is chained (install) or was restored (uninstall)
+}
+
+// IsHook reports whether name is a hook managed by JiraFlow
+func IsHook(name string) bool {
+	for _, hook := range Names {
+		if name == hook {
+			return true
+		}
+	}
+	return false
+}
+
+// Script returns the shell script of a hook that runs the chained hook first and then
+// 'jiraflow hooks run'. executable is used if it still exists, jiraflow from PATH otherwise.
+func Script(hook, executable string) string {
+	var script strings.Builder
+	fmt.Fprintf(&script, "#!/bin/sh\n%s: installed by 'jiraflow hooks install', remove with 'jiraflow hooks uninstall'\n", marker)
+	fmt.Fprintf(&script, "# A hook that existed before is kept as %s%s and runs first\n\n", hook, ChainedSuffix)
+	fmt.Fprintf(&script, "jiraflow=%s\n[ -x \"$jiraflow\" ] || jiraflow=jiraflow\n", shellQuote(executable))
+	fmt.Fprintf(&script, "chained=\"$0%s\"\n\n", ChainedSuffix)
+
+	if hook == PrePush {
+		// The pushed refs arrive on stdin, which both hooks need to read
+		script.WriteString("input=$(mktemp) || exit 1\ntrap 'rm -f \"$input\"' EXIT\ncat > \"$input\"\n\n")
+		script.WriteString("if [ -x \"$chained\" ]; then\n\t\"$chained\" \"$@\" < \"$input\" || exit $?\nfi\n")
+		fmt.Fprintf(&script, "\"$jiraflow\" hooks run %s \"$@\" < \"$input\"\n", hook)
+	} else {
+		script.WriteString("if [ -x \"$chained\" ]; then\n\t\"$chained\" \"$@\" || exit $?\nfi\n")
+		fmt.Fprintf(&script, "exec \"$jiraflow\" hooks run %s \"$@\"\n", hook)
+	}
+
+	return script.String()
+}
+
+// shellQuote quotes a string for POSIX shells
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// IsManaged reports whether the hook file at path was written by JiraFlow
+func IsManaged(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return strings.Contains(string(data), marker), nil
+}
+
+// Install writes the given hooks into the hooks directory
+// Existing JiraFlow hooks are updated; other existing hooks are renamed with ChainedSuffix
+// and run before the JiraFlow hook, so they keep working
+func Install(dir, executable string, names []string) ([]Result, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create hooks directory %s: %w", dir, err)
+	}
+
+	results := make([]Result, 0, len(names))
+	for _, hook := range names {
+		path := filepath.Join(dir, hook)
+		result := Result{Hook: hook, Path: path, Action: "installed"}
+
+		managed, err := IsManaged(path)
+		if err != nil {
+			return results, fmt.Errorf("failed to read hook %s: %w", path, err)
+		}
+		if managed {
+			result.Action = "updated"
+		} else if _, err := os.Lstat(path); err == nil {
+			chained := path + ChainedSuffix
+			if _, err := os.Lstat(chained); err == nil {
+				return results, fmt.Errorf("cannot chain hook %s: %s already exists", path, chained)
+			}
+			if err := os.Rename(path, chained); err != nil {
+				return results, fmt.Errorf("failed to keep existing hook %s: %w", path, err)
+			}
+		}
+		if _, err := os.Lstat(path + ChainedSuffix); err == nil {
+			result.Chained = true
+		}
+
+		if err := os.WriteFile(path, []byte(Script(hook, executable)), 0755); err != nil {
+			return results, fmt.Errorf("failed to write hook %s: %w", path, err)
+		}
+		// WriteFile keeps the mode of an existing file
+		if err := os.Chmod(path, 0755); err != nil {
+			return results, fmt.Errorf("failed to make hook %s executable: %w", path, err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// Uninstall removes the given JiraFlow hooks and restores the hooks they chained
+// Hooks not written by JiraFlow are left untouched
+func Uninstall(dir string, names []string) ([]Result, error) {
+	results := make([]Result, 0, len(names))
+	for _, hook := range names {
+		path := filepath.Join(dir, hook)
+		result := Result{Hook: hook, Path: path, Action: "not installed"}
+
+		managed, err := IsManaged(path)
+		if err != nil {
+			return results, fmt.Errorf("failed to read hook %s: %w", path, err)
+		}
+		if managed {
+			if err := os.Remove(path); err != nil {
+				return results, fmt.Errorf("failed to remove hook %s: %w", path, err)
+			}
+			result.Action = "removed"
+
+			chained := path + ChainedSuffix
+			if _, err := os.Lstat(chained); err == nil {
+				if err := os.Rename(chained, path); err != nil {
+					return results, fmt.Errorf("failed to restore hook %s: %w", chained, err)
+				}
+				result.Chained = true
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
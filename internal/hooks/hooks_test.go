@@ -0,0 +1,276 @@
+package hooks
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestInstallAndUninstall(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "hooks")
+
+	// The directory is created and every hook is written executable
+	results, err := Install(dir, "/usr/local/bin/jiraflow", Names)
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if len(results) != len(Names) {
+		t.Fatalf("Install() returned %d results, want %d", len(results), len(Names))
+	}
+	for i, result := range results {
+		if result.Hook != Names[i] || result.Action != "installed" || result.Chained {
+			t.Errorf("Install() result = %+v", result)
+		}
+		info, err := os.Stat(result.Path)
+		if err != nil {
+			t.Fatalf("hook %s not written: %v", result.Path, err)
+		}
+		if info.Mode().Perm()&0111 == 0 {
+			t.Errorf("hook %s is not executable", result.Path)
+		}
+	}
+
+	// Installing again updates the hooks
+	results, err = Install(dir, "/usr/local/bin/jiraflow", []string{CommitMsg})
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if results[0].Action != "updated" {
+		t.Errorf("second Install() action = %q, want updated", results[0].Action)
+	}
+
+	results, err = Uninstall(dir, Names)
+	if err != nil {
+		t.Fatalf("Uninstall() error = %v", err)
+	}
+	for _, result := range results {
+		if result.Action != "removed" || result.Chained {
+			t.Errorf("Uninstall() result = %+v", result)
+		}
+		if _, err := os.Stat(result.Path); !os.IsNotExist(err) {
+			t.Errorf("hook %s still exists", result.Path)
+		}
+	}
+}
+
+func TestInstall_ChainsExistingHook(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, CommitMsg)
+	existing := "#!/bin/sh\necho existing\n"
+	if err := os.WriteFile(path, []byte(existing), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := Install(dir, "jiraflow", []string{CommitMsg})
+	if err != nil {
+		t.Fatalf("Install() error = %v", err)
+	}
+	if !results[0].Chained {
+		t.Errorf("Install() result = %+v, want chained", results[0])
+	}
+	chained, err := os.ReadFile(path + ChainedSuffix)
+	if err != nil || string(chained) != existing {
+		t.Errorf("existing hook not kept as %s%s: %q, %v", CommitMsg, ChainedSuffix, chained, err)
+	}
+	if managed, _ := IsManaged(path); !managed {
+		t.Errorf("IsManaged(%s) = false after Install()", path)
+	}
+
+	// Updating keeps the chained hook
+	results, err = Install(dir, "jiraflow", []string{CommitMsg})
+	if err != nil || results[0].Action != "updated" || !results[0].Chained {
+		t.Errorf("second Install() = %+v, %v", results, err)
+	}
+
+	results, err = Uninstall(dir, []string{CommitMsg})
+	if err != nil || !results[0].Chained {
+		t.Errorf("Uninstall() = %+v, %v", results, err)
+	}
+	restored, err := os.ReadFile(path)
+	if err != nil || string(restored) != existing {
+		t.Errorf("existing hook not restored: %q, %v", restored, err)
+	}
+	if _, err := os.Stat(path + ChainedSuffix); !os.IsNotExist(err) {
+		t.Errorf("chained hook still exists after Uninstall()")
+	}
+}
+
+func TestInstall_ChainedHookConflict(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, PrePush)
+	for _, file := range []string{path, path + ChainedSuffix} {
+		if err := os.WriteFile(file, []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := Install(dir, "jiraflow", []string{PrePush}); err == nil {
+		t.Error("Install() error = nil, want an error when the chained hook already exists")
+	}
+	if managed, _ := IsManaged(path); managed {
+		t.Error("Install() overwrote the existing hook")
+	}
+}
+
+func TestUninstall_LeavesForeignHooks(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, PrePush)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := Uninstall(dir, []string{PrePush, CommitMsg})
+	if err != nil {
+		t.Fatalf("Uninstall() error = %v", err)
+	}
+	for _, result := range results {
+		if result.Action != "not installed" {
+			t.Errorf("Uninstall() result = %+v", result)
+		}
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Uninstall() removed a foreign hook: %v", err)
+	}
+}
+
+func TestScript_RunsChainedHookFirst(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+
+	dir := t.TempDir()
+	log := filepath.Join(dir, "log")
+
+	// A fake jiraflow and a chained hook that record their arguments and input
+	fake := filepath.Join(dir, "fake jiraflow")
+	record := "#!/bin/sh\necho \"$(basename \"$0\") $* $(cat)\" >> '" + log + "'\n"
+	if err := os.WriteFile(fake, []byte(record), 0755); err != nil {
+		t.Fatal(err)
+	}
+	hook := filepath.Join(dir, PrePush)
+	if err := os.WriteFile(hook+ChainedSuffix, []byte(record), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(hook, []byte(Script(PrePush, fake)), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(hook, "origin", "url")
+	cmd.Stdin = strings.NewReader("refs")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("hook failed: %v\n%s", err, output)
+	}
+
+	data, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "pre-push.jiraflow-chained origin url refs\nfake jiraflow hooks run pre-push origin url refs\n"
+	if string(data) != want {
+		t.Errorf("hook calls = %q, want %q", data, want)
+	}
+
+	// A failing chained hook stops the JiraFlow hook
+	if err := os.WriteFile(hook+ChainedSuffix, []byte("#!/bin/sh\nexit 3\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	cmd = exec.Command(hook)
+	cmd.Stdin = strings.NewReader("")
+	if err := cmd.Run(); err == nil {
+		t.Error("hook succeeded although the chained hook failed")
+	} else if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 3 {
+		t.Errorf("hook error = %v, want exit status 3", err)
+	}
+}
+
+func TestHasTicketKey(t *testing.T) {
+	tests := []struct {
+		message string
+		want    bool
+	}{
+		{"PROJ-123: Add login", true},
+		{"Add login\n\nRefs ABC2-7", true},
+		{"Add login", false},
+		{"Add login\n# PROJ-123 in a comment", false},
+		{"proj-123 lower case", false},
+		{"Add login\n" + scissorsLine + "\nPROJ-123 in the diff", false},
+	}
+
+	for _, tt := range tests {
+		if got := HasTicketKey(tt.message); got != tt.want {
+			t.Errorf("HasTicketKey(%q) = %v, want %v", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestIsExempt(t *testing.T) {
+	tests := []struct {
+		message string
+		want    bool
+	}{
+		{"Merge branch 'main' into feature/PROJ-1-x", true},
+		{"Revert \"Add login\"\n\nThis reverts commit abc.", true},
+		{"fixup! Add login", true},
+		{"squash! Add login", true},
+		{"# comment\n\nMerge pull request #1", true},
+		{"Add login", false},
+		{"Merged the changes", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsExempt(tt.message); got != tt.want {
+			t.Errorf("IsExempt(%q) = %v, want %v", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		prefix  string
+		ticket  string
+		want    string
+	}{
+		{"default prefix", "Add login\n", "", "PROJ-1", "PROJ-1: Add login\n"},
+		{"custom prefix", "Add login\n", "[{ticket}] ", "PROJ-1", "[PROJ-1] Add login\n"},
+		{"empty message", "\n# Please enter the commit message\n", "", "PROJ-1", "PROJ-1: \n# Please enter the commit message\n"},
+		{"already mentioned", "Add login\n\nRefs PROJ-1\n", "", "PROJ-1", "Add login\n\nRefs PROJ-1\n"},
+		{"mentioned in a comment only", "Add login\n# On branch feature/PROJ-1-x\n", "", "PROJ-1", "PROJ-1: Add login\n# On branch feature/PROJ-1-x\n"},
+		{"no ticket", "Add login\n", "", "", "Add login\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrefixMessage(tt.message, tt.prefix, tt.ticket); got != tt.want {
+				t.Errorf("PrefixMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushedBranches(t *testing.T) {
+	sha := "1111111111111111111111111111111111111111"
+	zero := "0000000000000000000000000000000000000000"
+	input := strings.Join([]string{
+		"refs/heads/feature/PROJ-1-x " + sha + " refs/heads/feature/PROJ-1-x " + zero,
+		"refs/heads/old " + zero + " refs/heads/old " + sha,
+		"refs/tags/v1.0 " + sha + " refs/tags/v1.0 " + zero,
+		"HEAD " + sha + " refs/heads/wip " + zero,
+		"refs/heads/bad_name " + sha + " refs/heads/bad_name " + sha,
+		"",
+	}, "\n")
+
+	got, err := PushedBranches(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("PushedBranches() error = %v", err)
+	}
+	want := []string{"feature/PROJ-1-x", "bad_name"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PushedBranches() = %v, want %v", got, want)
+	}
+}
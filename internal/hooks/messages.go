@@ -0,0 +1,91 @@
+package hooks
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// ticketKeyPattern matches a Jira ticket key anywhere in a commit message
+var ticketKeyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]*-[0-9]+\b`)
+
+// scissorsLine starts the part of a commit message that git removes (git commit --verbose)
+const scissorsLine = "# ------------------------ >8 ------------------------"
+
+// exemptPrefixes start commit messages that are written by Git and need no ticket key
+var exemptPrefixes = []string{"Merge ", "Revert \"", "fixup! ", "squash! ", "amend! "}
+
+// DefaultCommitPrefix is used when no commit prefix is configured
+const DefaultCommitPrefix = "{ticket}: "
+
+// messageLines returns the lines of a commit message that git keeps: comment lines
+// and everything below the scissors line are left out
+func messageLines(message string) []string {
+	var lines []string
+	for _, line := range strings.Split(message, "\n") {
+		if line == scissorsLine {
+			break
+		}
+		if !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// HasTicketKey reports whether a commit message mentions a ticket key outside comments
+func HasTicketKey(message string) bool {
+	return ticketKeyPattern.MatchString(strings.Join(messageLines(message), "\n"))
+}
+
+// IsExempt reports whether a commit message was generated by Git for a merge, revert or fixup
+func IsExempt(message string) bool {
+	for _, line := range messageLines(message) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		for _, prefix := range exemptPrefixes {
+			if strings.HasPrefix(line, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// PrefixMessage adds the prefix, with {ticket} replaced by the ticket key, to the first
+// line of a commit message unless the message already mentions the ticket key
+func PrefixMessage(message, prefix, ticket string) string {
+	if prefix == "" {
+		prefix = DefaultCommitPrefix
+	}
+	if ticket == "" || strings.Contains(strings.Join(messageLines(message), "\n"), ticket) {
+		return message
+	}
+	return strings.ReplaceAll(prefix, "{ticket}", ticket) + message
+}
+
+// PushedBranches returns the local branch names from the pre-push hook input
+// Each line reads "<local ref> <local sha> <remote ref> <remote sha>"; deletions and tags are skipped
+func PushedBranches(input io.Reader) ([]string, error) {
+	var branches []string
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 4 {
+			continue
+		}
+		localRef, localSHA := fields[0], fields[1]
+		if strings.Trim(localSHA, "0") == "" || !strings.HasPrefix(localRef, "refs/heads/") {
+			continue
+		}
+		branches = append(branches, strings.TrimPrefix(localRef, "refs/heads/"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read pushed refs: %w", err)
+	}
+	return branches, nil
+}
@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"jiraflow/internal/errors"
+)
+
+// maxCachedTickets is the number of tickets kept in the cache, most recently fetched first
+const maxCachedTickets = 200
+
+// CachedTicket is a ticket remembered in the cache with the time it was fetched
+type CachedTicket struct {
+	Ticket
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// TicketCache remembers tickets fetched from Jira, e.g. to suggest them in shell completion
+// without calling Jira; the cache is a JSON file
+type TicketCache struct {
+	path string
+	now  func() time.Time
+}
+
+// NewTicketCache creates a ticket cache stored in the given file
+func NewTicketCache(path string) *TicketCache {
+	return &TicketCache{path: path, now: time.Now}
+}
+
+// Load returns the cached tickets, most recently fetched first
+// A missing cache file is an empty cache
+func (c *TicketCache) Load() ([]CachedTicket, error) {
+	data, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var tickets []CachedTicket
+	if err := json.Unmarshal(data, &tickets); err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// Add stores the tickets at the front of the cache, replacing older entries of the same keys
+// Tickets without a summary are not cached
+func (c *TicketCache) Add(tickets ...Ticket) error {
+	cached, err := c.Load()
+	if err != nil {
+		// Start over instead of failing on a corrupt cache
+		cached = nil
+	}
+
+	now := c.now()
+	added := make(map[string]bool)
+	var updated []CachedTicket
+	for _, ticket := range tickets {
+		if ticket.Key == "" || ticket.Summary == "" || added[ticket.Key] {
+			continue
+		}
+		added[ticket.Key] = true
+		updated = append(updated, CachedTicket{Ticket: ticket, FetchedAt: now})
+	}
+	if len(updated) == 0 {
+		return nil
+	}
+	for _, ticket := range cached {
+		if !added[ticket.Key] {
+			updated = append(updated, ticket)
+		}
+	}
+	if len(updated) > maxCachedTickets {
+		updated = updated[:maxCachedTickets]
+	}
+
+	data, err := json.MarshalIndent(updated, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0600)
+}
+
+// CachingClient is a JiraClient that records the tickets it fetches in a cache
+// Failing to write the cache does not fail the Jira operation
+type CachingClient struct {
+	JiraClient
+	cache *TicketCache
+}
+
+// NewCachingClient wraps a Jira client so that fetched tickets are cached
+func NewCachingClient(client JiraClient, cache *TicketCache) *CachingClient {
+	return &CachingClient{JiraClient: client, cache: cache}
+}
+
+// GetTicketTitle fetches the ticket title and caches the ticket
+func (c *CachingClient) GetTicketTitle(ticketID string) (string, error) {
+	ticket, err := c.GetTicket(ticketID)
+	if err != nil {
+		return "", err
+	}
+
+	if ticket.Summary == "" {
+		return "", errors.NewJiraError(ticketID, "ticket title is empty", true)
+	}
+
+	return ticket.Summary, nil
+}
+
+// GetTicket fetches the ticket and caches it
+func (c *CachingClient) GetTicket(ticketID string) (*Ticket, error) {
+	ticket, err := c.JiraClient.GetTicket(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	_ = c.cache.Add(*ticket)
+	return ticket, nil
+}
+
+// SearchTickets searches tickets and caches the results
+func (c *CachingClient) SearchTickets(jql string, limit int) ([]Ticket, error) {
+	tickets, err := c.JiraClient.SearchTickets(jql, limit)
+	if err != nil {
+		return nil, err
+	}
+	_ = c.cache.Add(tickets...)
+	return tickets, nil
+}
@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTicketCache(t *testing.T) {
+	cache := NewTicketCache(filepath.Join(t.TempDir(), "state", "tickets.json"))
+	clock := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cache.now = func() time.Time { return clock }
+
+	if tickets, err := cache.Load(); err != nil || len(tickets) != 0 {
+		t.Fatalf("Load() of missing cache = %v, %v, want empty", tickets, err)
+	}
+
+	if err := cache.Add(Ticket{Key: "PROJ-1", Summary: "First"}, Ticket{Key: "PROJ-2", Summary: "Second"}, Ticket{Key: "PROJ-3"}); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	clock = clock.Add(time.Hour)
+	if err := cache.Add(Ticket{Key: "PROJ-2", Summary: "Second, renamed"}); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+
+	tickets, err := cache.Load()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("Load() = %+v, want PROJ-2 and PROJ-1", tickets)
+	}
+	if tickets[0].Key != "PROJ-2" || tickets[0].Summary != "Second, renamed" || !tickets[0].FetchedAt.Equal(clock) {
+		t.Errorf("first cached ticket = %+v, want the renamed PROJ-2", tickets[0])
+	}
+	if tickets[1].Key != "PROJ-1" {
+		t.Errorf("second cached ticket = %+v, want PROJ-1", tickets[1])
+	}
+
+	// A corrupt cache is replaced
+	if err := os.WriteFile(cache.path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Add(Ticket{Key: "PROJ-4", Summary: "Fourth"}); err != nil {
+		t.Fatalf("Add() to corrupt cache unexpected error: %v", err)
+	}
+	if tickets, _ := cache.Load(); len(tickets) != 1 || tickets[0].Key != "PROJ-4" {
+		t.Errorf("Load() after corrupt cache = %+v, want PROJ-4 only", tickets)
+	}
+}
+
+func TestCachingClient(t *testing.T) {
+	mock := NewMockClient()
+	mock.SetTicket("PROJ-1", "Add login")
+	mock.SetTicket("PROJ-2", "Fix logout")
+	cache := NewTicketCache(filepath.Join(t.TempDir(), "tickets.json"))
+	client := NewCachingClient(mock, cache)
+
+	if title, err := client.GetTicketTitle("PROJ-1"); err != nil || title != "Add login" {
+		t.Fatalf("GetTicketTitle() = %q, %v", title, err)
+	}
+	if _, err := client.GetTicketTitle("PROJ-9"); err == nil {
+		t.Error("GetTicketTitle() for unknown ticket: expected error")
+	}
+	if _, err := client.SearchTickets("project = PROJ", 0); err != nil {
+		t.Fatalf("SearchTickets() unexpected error: %v", err)
+	}
+
+	tickets, err := cache.Load()
+	if err != nil || len(tickets) != 2 {
+		t.Fatalf("cached tickets = %+v, %v, want PROJ-1 and PROJ-2", tickets, err)
+	}
+	if !client.IsAvailable() {
+		t.Error("IsAvailable() not delegated to the wrapped client")
+	}
+}
@@ -4,94 +4,200 @@ import (
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"sort"
 	"strings"
 
 	"jiraflow/internal/errors"
 )
 
+// Ticket represents the subset of Jira issue fields used by JiraFlow
+type Ticket struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	Status  string `json:"status"`
+}
+
 // JiraClient interface defines Jira operations
 type JiraClient interface {
 	GetTicketTitle(ticketID string) (string, error)
+	GetTicket(ticketID string) (*Ticket, error)
+	SearchTickets(jql string, limit int) ([]Ticket, error)
 	IsAvailable() bool
 }
 
 // JiraError is an alias for the centralized JiraError type
 type JiraError = errors.JiraError
 
+// defaultCLIPath is the Jira CLI executable looked up on PATH
+const defaultCLIPath = "jira"
+
 // CLIClient implements JiraClient using the Jira CLI
-type CLIClient struct{}
+type CLIClient struct {
+	cliPath    string
+	configFile string
+}
 
-// NewCLIClient creates a new Jira CLI client
+// NewCLIClient creates a new Jira CLI client using jira from PATH and its default configuration
 func NewCLIClient() *CLIClient {
-	return &CLIClient{}
+	return NewConfiguredCLIClient("", "")
+}
+
+// NewConfiguredCLIClient creates a Jira CLI client for a specific executable and Jira CLI config file
+// Empty values select jira from PATH and the Jira CLI's own default configuration
+func NewConfiguredCLIClient(cliPath, configFile string) *CLIClient {
+	if cliPath == "" {
+		cliPath = defaultCLIPath
+	}
+	return &CLIClient{cliPath: cliPath, configFile: configFile}
 }
 
 // IsAvailable checks if the Jira CLI is installed and available
 func (c *CLIClient) IsAvailable() bool {
-	_, err := exec.LookPath("jira")
+	_, err := exec.LookPath(c.cliPath)
 	return err == nil
 }
 
+// commandArgs returns the Jira CLI arguments, selecting the configured Jira CLI config file
+func (c *CLIClient) commandArgs(args ...string) []string {
+	if c.configFile != "" {
+		args = append(args, "--config", c.configFile)
+	}
+	return args
+}
+
 // GetTicketTitle fetches the ticket title using the Jira CLI
 func (c *CLIClient) GetTicketTitle(ticketID string) (string, error) {
+	ticket, err := c.GetTicket(ticketID)
+	if err != nil {
+		return "", err
+	}
+
+	if ticket.Summary == "" {
+		return "", errors.NewJiraError(ticketID, "ticket title is empty", true)
+	}
+
+	return ticket.Summary, nil
+}
+
+// GetTicket fetches the ticket key, summary and status using the Jira CLI
+func (c *CLIClient) GetTicket(ticketID string) (*Ticket, error) {
 	if !c.IsAvailable() {
-		return "", errors.NewJiraError(ticketID, "jira CLI not found - please install jira CLI or provide title manually", true)
+		return nil, errors.NewJiraError(ticketID, "jira CLI not found - please install jira CLI or provide title manually", true)
 	}
 
 	// Execute jira issue view command with raw JSON output
-	cmd := exec.Command("jira", "issue", "view", ticketID, "--raw")
+	cmd := exec.Command(c.cliPath, c.commandArgs("issue", "view", ticketID, "--raw")...)
 	output, err := cmd.Output()
 	if err != nil {
 		// Try to get more specific error information
 		if exitError, ok := err.(*exec.ExitError); ok {
 			stderr := string(exitError.Stderr)
 			if strings.Contains(stderr, "not found") || strings.Contains(stderr, "does not exist") {
-				return "", errors.NewJiraError(ticketID, fmt.Sprintf("ticket %s not found", ticketID), true)
+				return nil, errors.NewJiraError(ticketID, fmt.Sprintf("ticket %s not found", ticketID), true)
 			}
 			if strings.Contains(stderr, "authentication") || strings.Contains(stderr, "unauthorized") {
-				return "", errors.NewJiraError(ticketID, "authentication failed - please run 'jira init' to configure credentials", true)
+				return nil, errors.NewJiraError(ticketID, "authentication failed - please run 'jira init' to configure credentials", true)
 			}
-			return "", errors.NewJiraError(ticketID, fmt.Sprintf("failed to fetch ticket: %s", stderr), true)
+			return nil, errors.NewJiraError(ticketID, fmt.Sprintf("failed to fetch ticket: %s", stderr), true)
 		}
-		return "", errors.NewJiraError(ticketID, fmt.Sprintf("failed to execute jira command: %v", err), true)
+		return nil, errors.NewJiraError(ticketID, fmt.Sprintf("failed to execute jira command: %v", err), true)
 	}
 
-	// Parse the JSON output to extract the title
-	title, err := c.parseJSONTitle(string(output))
+	// Parse the JSON output to extract the ticket fields
+	ticket, err := c.parseJSONTicket(string(output))
 	if err != nil {
-		return "", errors.NewJiraError(ticketID, fmt.Sprintf("failed to parse ticket title: %v", err), true)
+		return nil, errors.NewJiraError(ticketID, fmt.Sprintf("failed to parse ticket: %v", err), true)
 	}
 
-	if title == "" {
-		return "", errors.NewJiraError(ticketID, "ticket title is empty", true)
+	if ticket.Key == "" {
+		ticket.Key = ticketID
 	}
 
-	return title, nil
+	return ticket, nil
+}
+
+// SearchTickets returns up to limit tickets matching a JQL query using the Jira CLI
+func (c *CLIClient) SearchTickets(jql string, limit int) ([]Ticket, error) {
+	if !c.IsAvailable() {
+		return nil, errors.NewJiraError("", "jira CLI not found - please install jira CLI to search tickets", true)
+	}
+
+	args := []string{"issue", "list", "--jql", jql, "--plain", "--no-headers", "--no-truncate", "--columns", "key,summary,status"}
+	if limit > 0 {
+		args = append(args, "--paginate", fmt.Sprintf("0:%d", limit))
+	}
+	cmd := exec.Command(c.cliPath, c.commandArgs(args...)...)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			stderr := strings.TrimSpace(string(exitError.Stderr))
+			if strings.Contains(stderr, "authentication") || strings.Contains(stderr, "unauthorized") {
+				return nil, errors.NewJiraError("", "authentication failed - please run 'jira init' to configure credentials", true)
+			}
+			return nil, errors.NewJiraError("", fmt.Sprintf("failed to search tickets: %s", stderr), true)
+		}
+		return nil, errors.NewJiraError("", fmt.Sprintf("failed to execute jira command: %v", err), true)
+	}
+
+	return parsePlainTicketList(string(output)), nil
 }
 
+// parsePlainTicketList parses the key, summary and status columns of jira issue list --plain
+// Columns are separated by one or more tabs
+func parsePlainTicketList(output string) []Ticket {
+	var tickets []Ticket
+	for _, line := range strings.Split(output, "\n") {
+		var fields []string
+		for _, field := range strings.Split(line, "\t") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) == 0 || !isTicketKey(fields[0]) {
+			continue
+		}
 
+		ticket := Ticket{Key: fields[0]}
+		if len(fields) > 1 {
+			ticket.Summary = fields[1]
+		}
+		if len(fields) > 2 {
+			ticket.Status = fields[2]
+		}
+		tickets = append(tickets, ticket)
+	}
+	return tickets
+}
 
-// parseJSONTitle parses the JSON output from jira CLI --raw command
-func (c *CLIClient) parseJSONTitle(output string) (string, error) {
+// parseJSONTicket parses the JSON output from jira CLI --raw command
+func (c *CLIClient) parseJSONTicket(output string) (*Ticket, error) {
 	// Parse the JSON response from jira issue view --raw
-	var ticket struct {
+	var raw struct {
+		Key    string `json:"key"`
 		Fields struct {
 			Summary string `json:"summary"`
+			Status  struct {
+				Name string `json:"name"`
+			} `json:"status"`
 		} `json:"fields"`
 	}
 	
-	if err := json.Unmarshal([]byte(output), &ticket); err != nil {
-		return "", fmt.Errorf("failed to parse JSON response: %v", err)
+	if err := json.Unmarshal([]byte(output), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %v", err)
 	}
 
-	// Return the summary field which contains the ticket title
-	return ticket.Fields.Summary, nil
+	return &Ticket{
+		Key:     raw.Key,
+		Summary: raw.Fields.Summary,
+		Status:  raw.Fields.Status.Name,
+	}, nil
 }
 
 // MockClient implements JiraClient for testing purposes
 type MockClient struct {
 	Available bool
 	Tickets   map[string]string
+	Statuses  map[string]string
 	Error     error
 }
 
@@ -100,6 +206,7 @@ func NewMockClient() *MockClient {
 	return &MockClient{
 		Available: true,
 		Tickets:   make(map[string]string),
+		Statuses:  make(map[string]string),
 	}
 }
 
@@ -126,11 +233,56 @@ func (m *MockClient) GetTicketTitle(ticketID string) (string, error) {
 	return title, nil
 }
 
+// GetTicket returns the mock ticket or error
+func (m *MockClient) GetTicket(ticketID string) (*Ticket, error) {
+	title, err := m.GetTicketTitle(ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ticket{
+		Key:     ticketID,
+		Summary: title,
+		Status:  m.Statuses[ticketID],
+	}, nil
+}
+
+// SearchTickets returns all mock tickets sorted by key; the query is ignored
+func (m *MockClient) SearchTickets(jql string, limit int) ([]Ticket, error) {
+	if m.Error != nil {
+		return nil, m.Error
+	}
+
+	if !m.Available {
+		return nil, errors.NewJiraError("", "jira CLI not available", true)
+	}
+
+	keys := make([]string, 0, len(m.Tickets))
+	for key := range m.Tickets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+
+	tickets := make([]Ticket, 0, len(keys))
+	for _, key := range keys {
+		tickets = append(tickets, Ticket{Key: key, Summary: m.Tickets[key], Status: m.Statuses[key]})
+	}
+	return tickets, nil
+}
+
 // SetTicket adds a ticket to the mock client
 func (m *MockClient) SetTicket(ticketID, title string) {
 	m.Tickets[ticketID] = title
 }
 
+// SetStatus sets the workflow status returned for a ticket
+func (m *MockClient) SetStatus(ticketID, status string) {
+	m.Statuses[ticketID] = status
+}
+
 // SetError sets an error to be returned by GetTicketTitle
 func (m *MockClient) SetError(err error) {
 	m.Error = err
@@ -2,6 +2,8 @@ package jira
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	jiraflowErrors "jiraflow/internal/errors"
@@ -129,7 +131,7 @@ func TestMockClient_GetTicketTitle(t *testing.T) {
 	}
 }
 
-// Note: parseTicketTitle and parseJSONTitle are private methods, so we test them
+// Note: parseTicketTitle and parseJSONTicket are private methods, so we test them
 // indirectly through the public GetTicketTitle method using a mock that simulates
 // the jira CLI output. For comprehensive unit testing of parsing logic, we would
 // need to either export these methods or use build tags for testing.
@@ -639,4 +641,159 @@ func contains(s, substr string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+
+func TestCLIClient_ParseJSONTicket(t *testing.T) {
+	client := NewCLIClient()
+
+	tests := []struct {
+		name        string
+		output      string
+		want        Ticket
+		expectError bool
+	}{
+		{
+			name: "full ticket",
+			output: `{
+  "key": "PROJ-123",
+  "fields": {
+    "summary": "Implement user authentication",
+    "status": {"name": "In Progress"}
+  }
+}`,
+			want: Ticket{Key: "PROJ-123", Summary: "Implement user authentication", Status: "In Progress"},
+		},
+		{
+			name:   "missing status",
+			output: `{"key": "PROJ-1", "fields": {"summary": "No status"}}`,
+			want:   Ticket{Key: "PROJ-1", Summary: "No status"},
+		},
+		{
+			name:        "invalid JSON",
+			output:      "Invalid jira CLI output",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket, err := client.parseJSONTicket(tt.output)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("parseJSONTicket() expected error, got %+v", ticket)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseJSONTicket() unexpected error: %v", err)
+			}
+			if *ticket != tt.want {
+				t.Errorf("parseJSONTicket() = %+v, want %+v", *ticket, tt.want)
+			}
+		})
+	}
+}
+
+func TestMockClient_GetTicket(t *testing.T) {
+	client := NewMockClient()
+	client.SetTicket("PROJ-123", "Test ticket")
+	client.SetStatus("PROJ-123", "Done")
+
+	ticket, err := client.GetTicket("PROJ-123")
+	if err != nil {
+		t.Fatalf("GetTicket() unexpected error: %v", err)
+	}
+	if ticket.Key != "PROJ-123" || ticket.Summary != "Test ticket" || ticket.Status != "Done" {
+		t.Errorf("GetTicket() = %+v", *ticket)
+	}
+
+	if _, err := client.GetTicket("PROJ-999"); err == nil {
+		t.Error("GetTicket() expected error for unknown ticket")
+	}
+}
+
+func TestNewConfiguredCLIClient(t *testing.T) {
+	// A fake Jira CLI that reports its arguments as the ticket summary
+	script := filepath.Join(t.TempDir(), "fake-jira")
+	content := "#!/bin/sh\nprintf '{\"key\":\"PROJ-1\",\"fields\":{\"summary\":\"%s\"}}' \"$*\"\n"
+	if err := os.WriteFile(script, []byte(content), 0755); err != nil {
+		t.Fatalf("Failed to write fake jira CLI: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		configFile string
+		want       string
+	}{
+		{"default config", "", "issue view PROJ-1 --raw"},
+		{"custom config", "/tmp/work.yml", "issue view PROJ-1 --raw --config /tmp/work.yml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewConfiguredCLIClient(script, tt.configFile)
+			if !client.IsAvailable() {
+				t.Fatal("IsAvailable() = false for existing executable")
+			}
+
+			ticket, err := client.GetTicket("PROJ-1")
+			if err != nil {
+				t.Fatalf("GetTicket() unexpected error: %v", err)
+			}
+			if ticket.Summary != tt.want {
+				t.Errorf("jira CLI called with %q, want %q", ticket.Summary, tt.want)
+			}
+		})
+	}
+
+	if client := NewConfiguredCLIClient("", ""); client.cliPath != "jira" {
+		t.Errorf("NewConfiguredCLIClient() cliPath = %q, want jira", client.cliPath)
+	}
+}
+
+func TestCLIClient_SearchTickets(t *testing.T) {
+	// A fake Jira CLI that lists two tickets in plain mode and reports its arguments on stderr
+	script := filepath.Join(t.TempDir(), "fake-jira")
+	content := "#!/bin/sh\necho \"$*\" >&2\nprintf 'PROJ-1\\t\\tAdd login\\tTo Do\\nPROJ-2\\tFix logout\\t\\tIn Progress\\n'\n"
+	if err := os.WriteFile(script, []byte(content), 0755); err != nil {
+		t.Fatalf("Failed to write fake jira CLI: %v", err)
+	}
+
+	client := NewConfiguredCLIClient(script, "")
+	tickets, err := client.SearchTickets("sprint in openSprints()", 10)
+	if err != nil {
+		t.Fatalf("SearchTickets() unexpected error: %v", err)
+	}
+	want := []Ticket{
+		{Key: "PROJ-1", Summary: "Add login", Status: "To Do"},
+		{Key: "PROJ-2", Summary: "Fix logout", Status: "In Progress"},
+	}
+	if len(tickets) != len(want) {
+		t.Fatalf("SearchTickets() = %+v, want %+v", tickets, want)
+	}
+	for i := range want {
+		if tickets[i] != want[i] {
+			t.Errorf("ticket %d = %+v, want %+v", i, tickets[i], want[i])
+		}
+	}
+
+	unavailable := NewConfiguredCLIClient(filepath.Join(t.TempDir(), "missing"), "")
+	if _, err := unavailable.SearchTickets("project = PROJ", 0); err == nil {
+		t.Error("SearchTickets() without jira CLI: expected error")
+	}
+}
+
+func TestMockClient_SearchTickets(t *testing.T) {
+	client := NewMockClient()
+	client.SetTicket("PROJ-2", "Second")
+	client.SetTicket("PROJ-1", "First")
+	client.SetStatus("PROJ-1", "Done")
+
+	tickets, err := client.SearchTickets("project = PROJ", 1)
+	if err != nil {
+		t.Fatalf("SearchTickets() unexpected error: %v", err)
+	}
+	if len(tickets) != 1 || tickets[0] != (Ticket{Key: "PROJ-1", Summary: "First", Status: "Done"}) {
+		t.Errorf("SearchTickets() = %+v, want PROJ-1 only", tickets)
+	}
+}
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// ticketKeyPattern finds a Jira ticket key in a line of text
+var ticketKeyPattern = regexp.MustCompile(`(^|[^A-Za-z0-9])([A-Za-z][A-Za-z0-9]*-[0-9]+)([^A-Za-z0-9]|$)`)
+
+// ParseTicketLine parses a "KEY title" line into a ticket
+// Tab separated lines, such as those of jira issue list --plain, take the title from the field
+// after the key; otherwise the title is the rest of the line after the key
+// The second result is false for blank lines, # comments and lines without a ticket key
+func ParseTicketLine(line string) (Ticket, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return Ticket{}, false
+	}
+
+	if strings.Contains(line, "\t") {
+		fields := strings.Split(line, "\t")
+		for i, field := range fields {
+			key := strings.TrimSpace(field)
+			if !isTicketKey(key) {
+				continue
+			}
+			ticket := Ticket{Key: strings.ToUpper(key)}
+			for _, rest := range fields[i+1:] {
+				if summary := strings.TrimSpace(rest); summary != "" {
+					ticket.Summary = summary
+					break
+				}
+			}
+			return ticket, true
+		}
+		return Ticket{}, false
+	}
+
+	match := ticketKeyPattern.FindStringSubmatchIndex(line)
+	if match == nil {
+		return Ticket{}, false
+	}
+	key := line[match[4]:match[5]]
+	summary := strings.TrimLeft(line[match[5]:], " :-–—|")
+	return Ticket{Key: strings.ToUpper(key), Summary: strings.TrimSpace(summary)}, true
+}
+
+// ParseTicketList parses "KEY title" lines, skipping lines without a ticket key
+func ParseTicketList(r io.Reader) ([]Ticket, error) {
+	var tickets []Ticket
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if ticket, ok := ParseTicketLine(scanner.Text()); ok {
+			tickets = append(tickets, ticket)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tickets: %w", err)
+	}
+	return tickets, nil
+}
+
+// isTicketKey reports whether the text is exactly a ticket key
+func isTicketKey(text string) bool {
+	match := ticketKeyPattern.FindStringSubmatchIndex(text)
+	return match != nil && match[4] == 0 && match[5] == len(text)
+}
@@ -0,0 +1,52 @@
+package jira
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTicketLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want Ticket
+		ok   bool
+	}{
+		{"key only", "PROJ-123", Ticket{Key: "PROJ-123"}, true},
+		{"key and title", "PROJ-123 Add user login", Ticket{Key: "PROJ-123", Summary: "Add user login"}, true},
+		{"separator after key", "PROJ-123: Add user login", Ticket{Key: "PROJ-123", Summary: "Add user login"}, true},
+		{"lowercase key", "proj-7 fix it", Ticket{Key: "PROJ-7", Summary: "fix it"}, true},
+		{"jira issue list --plain", "Story\tPROJ-9\t\tAdd search\tTo Do", Ticket{Key: "PROJ-9", Summary: "Add search"}, true},
+		{"leading text", "* PROJ-5 - Update docs", Ticket{Key: "PROJ-5", Summary: "Update docs"}, true},
+		{"blank", "   ", Ticket{}, false},
+		{"comment", "# PROJ-1 later", Ticket{}, false},
+		{"no key", "just a title", Ticket{}, false},
+		{"key followed by letters", "PROJ-1a", Ticket{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseTicketLine(tt.line)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("ParseTicketLine(%q) = %+v, %v, want %+v, %v", tt.line, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestParseTicketList(t *testing.T) {
+	input := "# sprint 42\nPROJ-1 Add login\n\nnot a ticket\nPROJ-2\n"
+	tickets, err := ParseTicketList(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseTicketList() unexpected error: %v", err)
+	}
+	want := []Ticket{{Key: "PROJ-1", Summary: "Add login"}, {Key: "PROJ-2"}}
+	if len(tickets) != len(want) {
+		t.Fatalf("ParseTicketList() = %+v, want %+v", tickets, want)
+	}
+	for i := range want {
+		if tickets[i] != want[i] {
+			t.Errorf("ticket %d = %+v, want %+v", i, tickets[i], want[i])
+		}
+	}
+}
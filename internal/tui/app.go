@@ -12,9 +12,11 @@ import (
 	"jiraflow/internal/config"
 	"jiraflow/internal/errors"
 	"jiraflow/internal/git"
+	"jiraflow/internal/history"
 	"jiraflow/internal/jira"
 	"jiraflow/internal/tui/components"
 	"jiraflow/internal/tui/models"
+	"jiraflow/internal/undo"
 )
 
 // AppState represents the current state of the TUI application
@@ -33,6 +35,7 @@ const (
 type AppModel struct {
 	state            AppState
 	config           *config.Config
+	generatorConfig  branch.GeneratorConfig
 	git              git.GitRepository
 	typeModel        models.TypeSelectorModel
 	branchModel      models.BranchSelectorModel
@@ -45,6 +48,13 @@ type AppModel struct {
 	width            int
 	height           int
 	
+	// Help overlay; onboarding shows the welcome text on first run
+	helpVisible      bool
+	onboarding       bool
+	
+	// History records created branches and provides the recent tickets; nil disables it
+	history          *history.Store
+	
 	// State data
 	selectedType   string
 	selectedBranch string
@@ -61,6 +71,7 @@ type keyMap struct {
 	Back   key.Binding
 	Quit   key.Binding
 	Search key.Binding
+	Help   key.Binding
 }
 
 // DefaultKeyMap returns the default key bindings
@@ -90,6 +101,10 @@ func DefaultKeyMap() keyMap {
 			key.WithKeys("/"),
 			key.WithHelp("/", "search"),
 		),
+		Help: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "help"),
+		),
 	}
 }
 
@@ -110,8 +125,9 @@ func NewAppModel(cfg *config.Config, gitRepo git.GitRepository) *AppModel {
 		// Note: Error will be handled gracefully during runtime
 	}
 	
-	// Initialize Jira client
-	jiraClient := jira.NewCLIClient()
+	// Initialize Jira client from the jira configuration section; fetched tickets are cached for shell completion
+	cliClient := jira.NewConfiguredCLIClient(config.ExpandHome(cfg.Jira.CLIPath), config.ExpandHome(cfg.Jira.ConfigFile))
+	jiraClient := jira.NewCachingClient(cliClient, jira.NewTicketCache(config.TicketCachePath(config.StateDir())))
 	
 	// Initialize input form model with Jira client
 	inputModel := models.NewInputFormModel(jiraClient)
@@ -123,6 +139,7 @@ func NewAppModel(cfg *config.Config, gitRepo git.GitRepository) *AppModel {
 	return &AppModel{
 		state:              StateTypeSelection,
 		config:             cfg,
+		generatorConfig:    branch.GeneratorConfigFromAppConfig(cfg.MaxBranchLength, cfg.Sanitization.Separator, cfg.Sanitization.Lowercase, cfg.Sanitization.RemoveUmlauts),
 		git:                gitRepo,
 		typeModel:          typeModel,
 		branchModel:        branchModel,
@@ -134,9 +151,28 @@ func NewAppModel(cfg *config.Config, gitRepo git.GitRepository) *AppModel {
 	}
 }
 
+// Options holds optional settings for the TUI application
+type Options struct {
+	// TicketNumber pre-fills the ticket number field
+	TicketNumber string
+	// Generator replaces the branch name generator settings, which by default only use the
+	// length and sanitization settings of the configuration
+	Generator *branch.GeneratorConfig
+	// Onboarding opens the welcome screen with the keyboard shortcuts before the first step
+	Onboarding bool
+	// History records the created branch and offers its recent tickets in the ticket step
+	History *history.Store
+}
+
 // RunTUI starts the TUI application
 func RunTUI(cfg *config.Config, gitRepo git.GitRepository) error {
+	return RunTUIWithOptions(cfg, gitRepo, Options{})
+}
+
+// RunTUIWithOptions starts the TUI application with the given options
+func RunTUIWithOptions(cfg *config.Config, gitRepo git.GitRepository, opts Options) error {
 	model := NewAppModel(cfg, gitRepo)
+	model.applyOptions(opts)
 	
 	p := tea.NewProgram(
 		model,
@@ -146,7 +182,7 @@ func RunTUI(cfg *config.Config, gitRepo git.GitRepository) error {
 	
 	finalModel, err := p.Run()
 	if err != nil {
-		return fmt.Errorf("TUI application error: %w", err)
+		return errors.NewTUIError("program", err.Error(), false)
 	}
 	
 	// Check if the final model has an error state
@@ -157,13 +193,37 @@ func RunTUI(cfg *config.Config, gitRepo git.GitRepository) error {
 		
 		// Check if user quit without completing the workflow
 		if appModel.state != StateComplete {
-			return fmt.Errorf("user cancelled operation")
+			return errors.ErrCancelled
 		}
 	}
 	
 	return nil
 }
 
+// applyOptions applies optional settings to a freshly created model
+func (m *AppModel) applyOptions(opts Options) {
+	if opts.TicketNumber != "" {
+		m.inputModel.SetTicketNumber(opts.TicketNumber)
+	}
+	if opts.Generator != nil {
+		m.generatorConfig = *opts.Generator
+	}
+	if opts.Onboarding {
+		m.helpVisible = true
+		m.onboarding = true
+	}
+	if opts.History != nil {
+		m.history = opts.History
+		if entries, err := opts.History.Load(); err == nil {
+			var recent []jira.Ticket
+			for _, entry := range history.RecentTickets(entries, 9) {
+				recent = append(recent, jira.Ticket{Key: entry.Ticket, Summary: entry.Title})
+			}
+			m.inputModel.SetRecentTickets(recent)
+		}
+	}
+}
+
 // Init initializes the TUI application
 func (m AppModel) Init() tea.Cmd {
 	return tea.EnterAltScreen
@@ -186,7 +246,20 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		// Any key closes the help overlay; ctrl+c still quits
+		if m.helpVisible {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m.helpVisible = false
+			m.onboarding = false
+			return m, nil
+		}
+
 		switch {
+		case key.Matches(msg, keys.Help) && !m.isTyping():
+			m.helpVisible = true
+			return m, nil
 		case key.Matches(msg, keys.Quit):
 			return m, tea.Quit
 		case key.Matches(msg, keys.Back):
@@ -213,6 +286,17 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// isTyping reports whether keys are entered as text, so that ? does not open the help
+func (m AppModel) isTyping() bool {
+	switch m.state {
+	case StateTicketInput, StateTitleInput:
+		return true
+	case StateBranchSelection:
+		return m.branchModel.IsSearching()
+	}
+	return false
+}
+
 // handleBack handles the back navigation
 func (m AppModel) handleBack() (tea.Model, tea.Cmd) {
 	switch m.state {
@@ -363,18 +447,20 @@ func (m AppModel) View() string {
 
 	var content string
 	
-	switch m.state {
-	case StateTypeSelection:
+	switch {
+	case m.helpVisible:
+		content = m.renderHelpOverlay()
+	case m.state == StateTypeSelection:
 		content = m.renderTypeSelection()
-	case StateBranchSelection:
+	case m.state == StateBranchSelection:
 		content = m.renderBranchSelection()
-	case StateTicketInput:
+	case m.state == StateTicketInput:
 		content = m.renderTicketInput()
-	case StateTitleInput:
+	case m.state == StateTitleInput:
 		content = m.renderTitleInput()
-	case StateConfirmation:
+	case m.state == StateConfirmation:
 		content = m.renderConfirmation()
-	case StateComplete:
+	case m.state == StateComplete:
 		content = m.renderComplete()
 	}
 
@@ -438,6 +524,13 @@ func (m AppModel) renderFooter() string {
 func (m AppModel) renderContextualHelp() string {
 	helpRenderer := components.NewHelpRenderer(m.width)
 	
+	if m.helpVisible {
+		return helpRenderer.RenderKeyBindings([]components.KeyBinding{
+			{Keys: []string{"any key"}, Description: "close help"},
+			{Keys: []string{"ctrl+c"}, Description: "force quit", Global: true},
+		})
+	}
+	
 	// Get key bindings for current state
 	bindings := m.getKeyBindings()
 	
@@ -593,9 +686,48 @@ func (m AppModel) getKeyBindings() []components.KeyBinding {
 		}
 	}
 	
+	if !m.isTyping() {
+		bindings = append(bindings, components.KeyBinding{Keys: []string{"?"}, Description: "help", Global: true})
+	}
+	
 	return bindings
 }
 
+// renderHelpOverlay renders the keyboard shortcuts of the current step, preceded on first run
+// by a short introduction to the workflow
+func (m AppModel) renderHelpOverlay() string {
+	helpRenderer := components.NewHelpRenderer(m.width)
+	
+	var stepBindings, globalBindings []components.KeyBinding
+	for _, binding := range m.getKeyBindings() {
+		if binding.Global {
+			globalBindings = append(globalBindings, binding)
+		} else {
+			stepBindings = append(stepBindings, binding)
+		}
+	}
+	
+	title := "⌨ Keyboard Shortcuts"
+	var intro string
+	if m.onboarding {
+		title = "👋 Welcome to JiraFlow"
+		intro = components.HelpStyle.UnsetMargins().Render(strings.Join([]string{
+			"JiraFlow creates a Git branch named after a Jira ticket in four steps:",
+			"  1. Select the branch type",
+			"  2. Select the base branch",
+			"  3. Enter the ticket key; the title is fetched from Jira",
+			"  4. Review the generated branch name and create the branch",
+		}, "\n"))
+	}
+	
+	return helpRenderer.RenderOverlay(title,
+		intro,
+		components.SubtitleStyle.Render("This step")+"\n"+helpRenderer.RenderKeyBindingTable(stepBindings),
+		components.SubtitleStyle.Render("Everywhere")+"\n"+helpRenderer.RenderKeyBindingTable(globalBindings),
+		components.HelpStyle.UnsetMargins().Render("Press ? to show this help again • any key to continue"),
+	)
+}
+
 // getContextInfo returns context information for the current state
 func (m AppModel) getContextInfo() []string {
 	var info []string
@@ -767,21 +899,32 @@ func (m AppModel) generateBranchName() string {
 		Title:    m.ticketTitle,
 	}
 	
-	// Create generator config from app config
-	generatorConfig := branch.GeneratorConfigFromAppConfig(
-		m.config.MaxBranchLength,
-		m.config.Sanitization.Separator,
-		m.config.Sanitization.Lowercase,
-		m.config.Sanitization.RemoveUmlauts,
-	)
-	
 	// Generate the branch name
-	return generator.GenerateNameWithConfig(branchInfo, generatorConfig)
+	return generator.GenerateNameWithConfig(branchInfo, m.generatorConfig)
 }
 
 // createBranch creates the new Git branch
 func (m AppModel) createBranch() error {
-	// Create and checkout the new branch
-	return m.git.CreateBranch(m.finalBranch, m.selectedBranch)
+	// Create and checkout the new branch, remembering the previous branch for undo
+	operation := undo.Begin(m.git, "interactive")
+	if err := m.git.CreateBranch(m.finalBranch, m.selectedBranch); err != nil {
+		return err
+	}
+	operation.AddBranch(m.git, m.finalBranch, m.selectedBranch)
+	_ = undo.Record(m.git, operation)
+	
+	// Record the branch; failing to write the history does not fail the workflow
+	if m.history != nil {
+		repo, _ := m.git.GetTopLevel()
+		_ = m.history.Add(history.Entry{
+			Repo:   repo,
+			Type:   m.selectedType,
+			Base:   m.selectedBranch,
+			Ticket: m.ticketNumber,
+			Title:  m.ticketTitle,
+			Branch: m.finalBranch,
+		})
+	}
+	return nil
 }
 
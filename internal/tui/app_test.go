@@ -1,11 +1,14 @@
 package tui
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"jiraflow/internal/branch"
 	"jiraflow/internal/config"
+	"jiraflow/internal/errors"
 	"jiraflow/internal/git"
 )
 
@@ -21,6 +24,16 @@ func (m *MockGitRepository) GetBranchesWithInfo() ([]git.BranchInfo, error) {
 	return m.branches, nil
 }
 
+func (m *MockGitRepository) GetRemoteBranches() ([]string, error) {
+	var names []string
+	for _, branch := range m.branches {
+		if branch.IsRemote {
+			names = append(names, branch.Name)
+		}
+	}
+	return names, nil
+}
+
 func (m *MockGitRepository) GetLocalBranches() ([]string, error) {
 	var names []string
 	for _, branch := range m.branches {
@@ -39,14 +52,82 @@ func (m *MockGitRepository) CreateBranch(name, baseBranch string) error {
 	return m.createError
 }
 
+func (m *MockGitRepository) CreateBranchWithoutCheckout(name, baseBranch string) error {
+	return m.createError
+}
+
 func (m *MockGitRepository) CheckoutBranch(name string) error {
 	return m.checkoutError
 }
 
+func (m *MockGitRepository) HasStagedChanges() (bool, error) {
+	return true, nil
+}
+
+func (m *MockGitRepository) Commit(message string, all bool) error {
+	return nil
+}
+
 func (m *MockGitRepository) IsGitRepository() bool {
 	return true
 }
 
+func (m *MockGitRepository) GetTopLevel() (string, error) {
+	return "", nil
+}
+
+func (m *MockGitRepository) GetHooksPath() (string, error) {
+	return "/tmp/.git/hooks", nil
+}
+
+func (m *MockGitRepository) GetRemoteURLs() ([]string, error) {
+	return []string{}, nil
+}
+
+func (m *MockGitRepository) ReadFileAtRef(ref, path string) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *MockGitRepository) GetBranchBase(name string) (string, error) {
+	return "", nil
+}
+
+func (m *MockGitRepository) GetAheadBehind(name, base string) (int, int, error) {
+	return 0, 0, nil
+}
+
+func (m *MockGitRepository) GetGitPath(name string) (string, error) {
+	return "", errors.NewGitError("rev-parse", "not supported by the mock", false)
+}
+
+func (m *MockGitRepository) ResolveRef(ref string) (string, error) {
+	return "", errors.NewGitError("rev-parse", "not supported by the mock", true)
+}
+
+func (m *MockGitRepository) DeleteBranch(name string) error {
+	return nil
+}
+
+func (m *MockGitRepository) HasUncommittedChanges() (bool, error) {
+	return false, nil
+}
+
+func (m *MockGitRepository) Stash(message string) (string, error) {
+	return "", nil
+}
+
+func (m *MockGitRepository) PopStash(ref string) error {
+	return nil
+}
+
+func (m *MockGitRepository) GetUpstream(name string) (string, string, error) {
+	return "", "", errors.NewGitError("config", "no upstream", true)
+}
+
+func (m *MockGitRepository) DeleteRemoteBranch(remote, name string) error {
+	return nil
+}
+
 func (m *MockGitRepository) SearchBranches(searchTerm string) (git.BranchSearchResult, error) {
 	branches, err := m.GetLocalBranches()
 	if err != nil {
@@ -353,6 +434,32 @@ func TestAppModel_BranchNameGeneration(t *testing.T) {
 	}
 }
 
+func TestAppModel_GeneratorOption(t *testing.T) {
+	cfg := config.GetDefaultConfig()
+	mockGit := &MockGitRepository{
+		branches: []git.BranchInfo{
+			{Name: "main", IsCurrent: true, IsRemote: false},
+		},
+		currentBranch: "main",
+	}
+
+	model := NewAppModel(cfg, mockGit)
+	model.applyOptions(Options{Generator: &branch.GeneratorConfig{
+		MaxBranchLength: 30,
+		Separator:       "_",
+		Lowercase:       true,
+		Shortening:      &branch.ShorteningOptions{Abbreviations: map[string]string{"configuration": "config"}},
+	}})
+	model.selectedType = "feature"
+	model.ticketNumber = "JIRA-123"
+	model.ticketTitle = "Update configuration loader"
+
+	expected := "feature/JIRA-123_update_config"
+	if branchName := model.generateBranchName(); branchName != expected {
+		t.Errorf("Expected branch name '%s', got '%s'", expected, branchName)
+	}
+}
+
 func TestAppModel_TitleSanitization(t *testing.T) {
 	cfg := config.GetDefaultConfig()
 	mockGit := &MockGitRepository{
@@ -470,4 +577,42 @@ func containsSubstring(s, substr string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+
+func TestAppModel_HelpOverlay(t *testing.T) {
+	cfg := config.GetDefaultConfig()
+	mockGit := &MockGitRepository{
+		branches:      []git.BranchInfo{{Name: "main", IsCurrent: true}},
+		currentBranch: "main",
+	}
+
+	model := NewAppModel(cfg, mockGit)
+	model.applyOptions(Options{Onboarding: true})
+	view := model.View()
+	if !model.helpVisible || !strings.Contains(view, "Welcome to JiraFlow") || !strings.Contains(view, "select type") {
+		t.Fatalf("onboarding View() = %q, want the welcome text and the shortcuts of the first step", view)
+	}
+
+	// Any key closes the overlay without acting on the step, q included
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	app := updated.(AppModel)
+	if app.helpVisible || app.onboarding || cmd != nil {
+		t.Errorf("q on the overlay: helpVisible = %v, onboarding = %v, cmd = %v", app.helpVisible, app.onboarding, cmd)
+	}
+
+	// ? opens the shortcuts again, without the welcome text
+	updated, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	app = updated.(AppModel)
+	view = app.View()
+	if !app.helpVisible || strings.Contains(view, "Welcome") || !strings.Contains(view, "Keyboard Shortcuts") {
+		t.Errorf("? View() = %q, want the keyboard shortcuts", view)
+	}
+
+	// While typing a ticket, ? is text
+	app.helpVisible = false
+	app.SetState(StateTicketInput)
+	updated, _ = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	if updated.(AppModel).helpVisible {
+		t.Error("? in the ticket input opened the help")
+	}
+}
@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"jiraflow/internal/batch"
+	"jiraflow/internal/errors"
+	"jiraflow/internal/tui/components"
+)
+
+// batchKeyMap defines the key bindings of the batch review
+type batchKeyMap struct {
+	Toggle    key.Binding
+	ToggleAll key.Binding
+	Confirm   key.Binding
+}
+
+var batchKeys = batchKeyMap{
+	Toggle:    key.NewBinding(key.WithKeys(" ", "x"), key.WithHelp("space", "toggle")),
+	ToggleAll: key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "toggle all")),
+	Confirm:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "create selected")),
+}
+
+// batchModel lets the user review the planned branches and choose which to create
+// Only pending items can be selected; the others are shown with the reason
+type batchModel struct {
+	items     []batch.Item
+	selected  []bool
+	cursor    int
+	height    int
+	done      bool
+	cancelled bool
+}
+
+// newBatchModel creates the review with every pending item selected
+func newBatchModel(items []batch.Item) batchModel {
+	selected := make([]bool, len(items))
+	for i, item := range items {
+		selected[i] = item.Status == batch.StatusPending
+	}
+	return batchModel{items: items, selected: selected}
+}
+
+// Init initializes the review
+func (m batchModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles review events
+func (m batchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit), key.Matches(msg, keys.Back):
+			m.cancelled = true
+			return m, tea.Quit
+		case key.Matches(msg, keys.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, keys.Down):
+			if m.cursor < len(m.items)-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, batchKeys.Toggle):
+			if m.cursor < len(m.items) && m.items[m.cursor].Status == batch.StatusPending {
+				m.selected[m.cursor] = !m.selected[m.cursor]
+			}
+		case key.Matches(msg, batchKeys.ToggleAll):
+			// Select all unless everything is selected already
+			all := m.selectedCount() < batch.Count(m.items, batch.StatusPending)
+			for i, item := range m.items {
+				m.selected[i] = all && item.Status == batch.StatusPending
+			}
+		case key.Matches(msg, batchKeys.Confirm):
+			if m.selectedCount() > 0 {
+				m.done = true
+				return m, tea.Quit
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// selectedCount returns the number of selected items
+func (m batchModel) selectedCount() int {
+	count := 0
+	for _, selected := range m.selected {
+		if selected {
+			count++
+		}
+	}
+	return count
+}
+
+// result marks the pending items that were not selected as skipped
+func (m batchModel) result() []batch.Item {
+	items := make([]batch.Item, len(m.items))
+	copy(items, m.items)
+	for i := range items {
+		if items[i].Status == batch.StatusPending && !m.selected[i] {
+			items[i].Status = batch.StatusSkipped
+		}
+	}
+	return items
+}
+
+// View renders the review
+func (m batchModel) View() string {
+	sections := []string{
+		components.TitleStyle.Render("Create Branches"),
+		components.SubtitleStyle.Render(fmt.Sprintf("%d of %d selected", m.selectedCount(), len(m.items))),
+	}
+
+	// Keep the cursor visible when the list is taller than the terminal
+	first, last := 0, len(m.items)
+	if visible := m.height - 8; m.height > 0 && visible > 0 && visible < len(m.items) {
+		first = max(0, min(m.cursor-visible/2, len(m.items)-visible))
+		last = first + visible
+	}
+
+	ticketWidth := 0
+	for _, item := range m.items {
+		ticketWidth = max(ticketWidth, len(item.Ticket))
+	}
+
+	var rows []string
+	for i := first; i < last; i++ {
+		item := m.items[i]
+		check := "[ ]"
+		if m.selected[i] {
+			check = "[x]"
+		} else if item.Status != batch.StatusPending {
+			check = " - "
+		}
+
+		row := fmt.Sprintf("%s %-*s  %s", check, ticketWidth, item.Ticket, item.Branch)
+		switch {
+		case item.Status != batch.StatusPending:
+			row = components.UnselectedStyle.Render(row) + "  " + components.WarningStyle.Render(item.Message)
+		case i == m.cursor:
+			row = components.SelectedStyle.Render(row)
+		default:
+			row = components.UnselectedStyle.Render(row)
+		}
+		if i == m.cursor {
+			row = components.FocusedStyle.Render("→ ") + row
+		} else {
+			row = "  " + row
+		}
+		rows = append(rows, row)
+	}
+	sections = append(sections, strings.Join(rows, "\n"))
+
+	if item := m.items[m.cursor]; item.Title != "" {
+		sections = append(sections, components.SubtitleStyle.Render(item.Title))
+	}
+
+	help := []string{"↑/↓ move", "space toggle", "a toggle all", "enter create selected", "esc cancel"}
+	sections = append(sections, components.HelpStyle.Render(strings.Join(help, " • ")))
+
+	return components.ContentStyle.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// RunBatchReview lets the user choose which of the planned branches to create
+// Pending items that were deselected are returned as skipped
+func RunBatchReview(items []batch.Item) ([]batch.Item, error) {
+	if batch.Count(items, batch.StatusPending) == 0 {
+		return items, nil
+	}
+
+	p := tea.NewProgram(newBatchModel(items))
+
+	finalModel, err := p.Run()
+	if err != nil {
+		return nil, errors.NewTUIError("program", err.Error(), false)
+	}
+
+	review, ok := finalModel.(batchModel)
+	if !ok || review.cancelled || !review.done {
+		return nil, errors.ErrCancelled
+	}
+
+	return review.result(), nil
+}
@@ -0,0 +1,77 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"jiraflow/internal/batch"
+)
+
+// sendBatchKeys feeds key presses to the batch review
+func sendBatchKeys(m batchModel, keys ...tea.KeyMsg) batchModel {
+	for _, k := range keys {
+		model, _ := m.Update(k)
+		m = model.(batchModel)
+	}
+	return m
+}
+
+func testBatchItems() []batch.Item {
+	return []batch.Item{
+		{Ticket: "PROJ-1", Title: "Add login", Branch: "feature/PROJ-1-add-login", Status: batch.StatusPending},
+		{Ticket: "PROJ-2", Branch: "feature/PROJ-2", Status: batch.StatusExists, Message: "branch already exists"},
+		{Ticket: "PROJ-3", Branch: "feature/PROJ-3", Status: batch.StatusPending},
+	}
+}
+
+func TestBatchModel_Select(t *testing.T) {
+	m := newBatchModel(testBatchItems())
+	if m.selectedCount() != 2 {
+		t.Fatalf("initially selected = %d, want every pending item", m.selectedCount())
+	}
+
+	// Existing branches cannot be selected; deselect PROJ-3
+	m = sendBatchKeys(m, typeText("j"), typeText(" "), typeText("j"), typeText(" "))
+	if m.selected[1] || m.selected[2] {
+		t.Errorf("selected = %v, want only PROJ-1", m.selected)
+	}
+	view := m.View()
+	if !strings.Contains(view, "1 of 3 selected") || !strings.Contains(view, "branch already exists") {
+		t.Errorf("View() = %q", view)
+	}
+
+	m = sendBatchKeys(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.done || m.cancelled {
+		t.Fatalf("enter: done = %v, cancelled = %v", m.done, m.cancelled)
+	}
+	want := []batch.Status{batch.StatusPending, batch.StatusExists, batch.StatusSkipped}
+	for i, item := range m.result() {
+		if item.Status != want[i] {
+			t.Errorf("result item %d status = %s, want %s", i, item.Status, want[i])
+		}
+	}
+}
+
+func TestBatchModel_ToggleAllAndCancel(t *testing.T) {
+	m := newBatchModel(testBatchItems())
+
+	m = sendBatchKeys(m, typeText("a"))
+	if m.selectedCount() != 0 {
+		t.Errorf("a with everything selected: selected = %d, want 0", m.selectedCount())
+	}
+	m = sendBatchKeys(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.done {
+		t.Error("enter without a selection finished the review")
+	}
+	m = sendBatchKeys(m, typeText("a"))
+	if m.selectedCount() != 2 {
+		t.Errorf("a: selected = %d, want 2", m.selectedCount())
+	}
+
+	m = sendBatchKeys(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if !m.cancelled || m.done {
+		t.Errorf("esc: cancelled = %v, done = %v", m.cancelled, m.done)
+	}
+}
@@ -0,0 +1,254 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"jiraflow/internal/commit"
+	"jiraflow/internal/errors"
+	"jiraflow/internal/tui/components"
+)
+
+// commitField is a field of the commit composer
+type commitField int
+
+const (
+	commitFieldType commitField = iota
+	commitFieldScope
+	commitFieldSubject
+	commitFieldBody
+	commitFieldCount
+)
+
+// commitKeyMap defines the key bindings of the commit composer
+type commitKeyMap struct {
+	Next     key.Binding
+	Previous key.Binding
+	Left     key.Binding
+	Right    key.Binding
+	Breaking key.Binding
+	Submit   key.Binding
+	Commit   key.Binding
+	Cancel   key.Binding
+}
+
+var commitKeys = commitKeyMap{
+	Next:     key.NewBinding(key.WithKeys("tab", "down"), key.WithHelp("tab/↓", "next field")),
+	Previous: key.NewBinding(key.WithKeys("shift+tab", "up"), key.WithHelp("shift+tab/↑", "previous field")),
+	Left:     key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "previous type")),
+	Right:    key.NewBinding(key.WithKeys("right", "l", " "), key.WithHelp("→/l", "next type")),
+	Breaking: key.NewBinding(key.WithKeys("!"), key.WithHelp("!", "toggle breaking change")),
+	Submit:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "commit")),
+	Commit:   key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "commit")),
+	Cancel:   key.NewBinding(key.WithKeys("esc", "ctrl+c"), key.WithHelp("esc", "cancel")),
+}
+
+// commitModel composes a Conventional Commits message
+type commitModel struct {
+	message   commit.Message
+	types     []string
+	typeIndex int
+	scope     textinput.Model
+	subject   textinput.Model
+	body      textarea.Model
+	field     commitField
+	done      bool
+	cancelled bool
+}
+
+// newCommitModel creates the composer prefilled with the draft message
+// The draft type is offered first if it is not one of the given types
+func newCommitModel(draft commit.Message, types []string) commitModel {
+	offered := types
+	index := -1
+	for i, commitType := range types {
+		if commitType == draft.Type {
+			index = i
+		}
+	}
+	if index < 0 {
+		offered = append([]string{draft.Type}, types...)
+		index = 0
+	}
+
+	scope := textinput.New()
+	scope.Placeholder = "optional, e.g. auth"
+	scope.CharLimit = 30
+	scope.Width = 20
+	scope.SetValue(draft.Scope)
+
+	subject := textinput.New()
+	subject.Placeholder = "short summary in imperative mood"
+	subject.CharLimit = 200
+	subject.Width = 60
+	subject.SetValue(draft.Subject)
+
+	body := textarea.New()
+	body.Placeholder = "optional, explain what and why"
+	body.ShowLineNumbers = false
+	body.SetWidth(72)
+	body.SetHeight(4)
+	body.SetValue(draft.Body)
+
+	m := commitModel{
+		message:   draft,
+		types:     offered,
+		typeIndex: index,
+		scope:     scope,
+		subject:   subject,
+		body:      body,
+	}
+	m.focus(commitFieldSubject)
+	return m
+}
+
+// Init initializes the composer
+func (m commitModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update handles composer events
+func (m commitModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Inputs with a placeholder need a positive width
+		m.subject.Width = max(20, min(60, msg.Width-10))
+		m.body.SetWidth(max(20, min(72, msg.Width-6)))
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, commitKeys.Cancel):
+			m.cancelled = true
+			return m, tea.Quit
+		case key.Matches(msg, commitKeys.Commit),
+			key.Matches(msg, commitKeys.Submit) && m.field != commitFieldBody:
+			if m.result().Subject != "" {
+				m.done = true
+				return m, tea.Quit
+			}
+			m.focus(commitFieldSubject)
+			return m, nil
+		case key.Matches(msg, commitKeys.Next) && (m.field != commitFieldBody || msg.String() == "tab"):
+			m.focus((m.field + 1) % commitFieldCount)
+			return m, nil
+		case key.Matches(msg, commitKeys.Previous) && (m.field != commitFieldBody || msg.String() == "shift+tab"):
+			m.focus((m.field + commitFieldCount - 1) % commitFieldCount)
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+		switch m.field {
+		case commitFieldType:
+			if key.Matches(msg, commitKeys.Breaking) {
+				m.message.Breaking = !m.message.Breaking
+			} else if key.Matches(msg, commitKeys.Left) {
+				m.typeIndex = (m.typeIndex + len(m.types) - 1) % len(m.types)
+			} else if key.Matches(msg, commitKeys.Right) {
+				m.typeIndex = (m.typeIndex + 1) % len(m.types)
+			}
+		case commitFieldScope:
+			m.scope, cmd = m.scope.Update(msg)
+		case commitFieldSubject:
+			m.subject, cmd = m.subject.Update(msg)
+		case commitFieldBody:
+			m.body, cmd = m.body.Update(msg)
+		}
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// focus moves the cursor to a field
+func (m *commitModel) focus(field commitField) {
+	m.field = field
+	m.scope.Blur()
+	m.subject.Blur()
+	m.body.Blur()
+	switch field {
+	case commitFieldScope:
+		m.scope.Focus()
+	case commitFieldSubject:
+		m.subject.Focus()
+	case commitFieldBody:
+		m.body.Focus()
+	}
+}
+
+// result returns the message composed so far
+func (m commitModel) result() commit.Message {
+	message := m.message
+	message.Type = m.types[m.typeIndex]
+	message.Scope = strings.TrimSpace(m.scope.Value())
+	message.Subject = strings.TrimSpace(m.subject.Value())
+	message.Body = strings.TrimSpace(m.body.Value())
+	return message
+}
+
+// View renders the composer
+func (m commitModel) View() string {
+	sections := []string{components.TitleStyle.Render("Compose Commit")}
+	if m.message.Ticket != "" {
+		sections = append(sections, components.SubtitleStyle.Render("Ticket "+m.message.Ticket))
+	}
+
+	typeValue := "‹ " + m.types[m.typeIndex] + " ›"
+	if m.message.Breaking {
+		typeValue += components.WarningStyle.Render("  ! breaking change")
+	}
+	sections = append(sections,
+		m.renderField(commitFieldType, "Type:", typeValue),
+		m.renderField(commitFieldScope, "Scope:", m.scope.View()),
+		m.renderField(commitFieldSubject, "Subject:", m.subject.View()),
+		m.renderField(commitFieldBody, "Body:", m.body.View()),
+	)
+
+	result := m.result()
+	if result.Subject == "" {
+		sections = append(sections, components.ErrorStyle.Render("⚠ A subject is required"))
+	} else {
+		preview := strings.TrimRight(result.String(), "\n")
+		sections = append(sections, components.SubtitleStyle.Render("Preview:"), components.BorderStyle.Render(preview))
+	}
+
+	help := []string{"tab next field", "←/→ change type", "! breaking change", "enter commit", "esc cancel"}
+	if m.field == commitFieldBody {
+		help = []string{"tab next field", "enter new line", "ctrl+s commit", "esc cancel"}
+	}
+	sections = append(sections, components.HelpStyle.Render(strings.Join(help, " • ")))
+
+	return components.ContentStyle.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// renderField renders a field label and its value
+func (m commitModel) renderField(field commitField, label, value string) string {
+	if m.field == field {
+		label = components.FocusedStyle.Render("→ " + label)
+	} else {
+		label = components.UnselectedStyle.Render("  " + label)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, label, "  "+value)
+}
+
+// RunCommitComposer lets the user edit the draft commit message and returns the result
+func RunCommitComposer(draft commit.Message, types []string) (commit.Message, error) {
+	p := tea.NewProgram(newCommitModel(draft, types))
+
+	finalModel, err := p.Run()
+	if err != nil {
+		return commit.Message{}, errors.NewTUIError("program", err.Error(), false)
+	}
+
+	composer, ok := finalModel.(commitModel)
+	if !ok || composer.cancelled || !composer.done {
+		return commit.Message{}, errors.ErrCancelled
+	}
+
+	return composer.result(), nil
+}
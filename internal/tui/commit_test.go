@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"jiraflow/internal/commit"
+)
+
+// sendKeys feeds key presses to the commit composer
+func sendKeys(m commitModel, keys ...tea.KeyMsg) commitModel {
+	for _, k := range keys {
+		model, _ := m.Update(k)
+		m = model.(commitModel)
+	}
+	return m
+}
+
+func typeText(text string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)}
+}
+
+func TestCommitModel_Compose(t *testing.T) {
+	draft := commit.Message{Type: "feat", Subject: "Add login form", Ticket: "PROJ-1", FooterToken: "Refs"}
+	m := newCommitModel(draft, commit.Types)
+
+	if m.field != commitFieldSubject {
+		t.Errorf("initial field = %v, want subject", m.field)
+	}
+	if got := m.result(); got != draft {
+		t.Errorf("initial result = %+v, want draft %+v", got, draft)
+	}
+
+	// Change the type, mark it breaking, set a scope and a body
+	m = sendKeys(m,
+		tea.KeyMsg{Type: tea.KeyShiftTab}, tea.KeyMsg{Type: tea.KeyShiftTab},
+		tea.KeyMsg{Type: tea.KeyRight}, typeText("!"),
+		tea.KeyMsg{Type: tea.KeyTab}, typeText("auth"),
+		tea.KeyMsg{Type: tea.KeyTab}, tea.KeyMsg{Type: tea.KeyTab}, typeText("Details"),
+		tea.KeyMsg{Type: tea.KeyEnter}, typeText("More"),
+	)
+	if m.done {
+		t.Fatal("enter in the body finished the composer")
+	}
+
+	m = sendKeys(m, tea.KeyMsg{Type: tea.KeyCtrlS})
+	if !m.done || m.cancelled {
+		t.Fatalf("ctrl+s: done = %v, cancelled = %v", m.done, m.cancelled)
+	}
+	want := "fix(auth)!: Add login form\n\nDetails\nMore\n\nRefs: PROJ-1\n"
+	if got := m.result().String(); got != want {
+		t.Errorf("result = %q, want %q", got, want)
+	}
+	if !strings.Contains(m.View(), "PROJ-1") {
+		t.Error("View() does not show the ticket")
+	}
+}
+
+func TestCommitModel_RequiresSubject(t *testing.T) {
+	m := newCommitModel(commit.Message{Type: "feat"}, commit.Types)
+
+	m = sendKeys(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.done {
+		t.Error("composer finished without a subject")
+	}
+
+	m = sendKeys(m, typeText("Add login"), tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.done || m.result().Subject != "Add login" {
+		t.Errorf("enter: done = %v, result = %+v", m.done, m.result())
+	}
+}
+
+func TestCommitModel_CustomTypeAndCancel(t *testing.T) {
+	m := newCommitModel(commit.Message{Type: "spike", Subject: "Try it"}, commit.Types)
+	if m.types[0] != "spike" || m.result().Type != "spike" {
+		t.Errorf("types = %v, want the draft type offered first", m.types)
+	}
+
+	m = sendKeys(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if !m.cancelled || m.done {
+		t.Errorf("esc: cancelled = %v, done = %v", m.cancelled, m.done)
+	}
+}
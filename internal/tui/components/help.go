@@ -77,6 +77,48 @@ func (h *HelpRenderer) renderBindingList(bindings []KeyBinding) string {
 	return strings.Join(items, " • ")
 }
 
+// RenderKeyBindingTable renders key bindings one per line with the keys aligned in a column
+func (h *HelpRenderer) RenderKeyBindingTable(bindings []KeyBinding) string {
+	keyWidth := 0
+	for _, binding := range bindings {
+		keyWidth = max(keyWidth, lipgloss.Width(strings.Join(binding.Keys, "/")))
+	}
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(ColorSecondary).
+		Bold(true).
+		Width(keyWidth + 2)
+
+	var lines []string
+	for _, binding := range bindings {
+		keys := keyStyle.Render(strings.Join(binding.Keys, "/"))
+		lines = append(lines, keys+HelpStyle.UnsetMargins().Render(binding.Description))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RenderOverlay renders a titled box with the given blocks, used for help screens over the content
+// The box is limited to the renderer width when it is known
+func (h *HelpRenderer) RenderOverlay(title string, blocks ...string) string {
+	// The title style already leaves a blank line below the title
+	parts := []string{TitleStyle.Render(title)}
+	for _, block := range blocks {
+		if block == "" {
+			continue
+		}
+		if len(parts) > 1 {
+			parts = append(parts, "")
+		}
+		parts = append(parts, block)
+	}
+
+	style := BorderStyle
+	if h.width > 8 {
+		style = style.MaxWidth(h.width)
+	}
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, parts...))
+}
+
 // RenderHelpSections renders multiple help sections
 func (h *HelpRenderer) RenderHelpSections(sections []HelpSection) string {
 	var rendered []string
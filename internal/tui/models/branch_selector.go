@@ -75,6 +75,7 @@ type BranchSelectorModel struct {
 	height         int
 	searchResults  git.BranchSearchResult
 	keyMap         BranchSelectorKeyMap
+	title          string
 }
 
 // BranchSelectorKeyMap defines key bindings for the branch selector
@@ -156,6 +157,7 @@ func NewBranchSelectorModel(branches []git.BranchInfo) BranchSelectorModel {
 		searching:     false,
 		keyMap:        DefaultBranchSelectorKeyMap(),
 		searchResults: git.BranchSearchResult{HasResults: true},
+		title:         "Select Base Branch",
 	}
 }
 
@@ -269,7 +271,7 @@ func (m BranchSelectorModel) View() string {
 	var sections []string
 
 	// Title section
-	title := components.TitleStyle.Render("Select Base Branch")
+	title := components.TitleStyle.Render(m.title)
 	sections = append(sections, title)
 
 	// Search input section
@@ -409,6 +411,12 @@ func (m BranchSelectorModel) GetCurrentItem() (BranchItem, bool) {
 	return BranchItem{}, false
 }
 
+// SetTitle sets the title shown above the branch list
+func (m *BranchSelectorModel) SetTitle(title string) {
+	m.title = title
+	m.list.Title = title
+}
+
 // SetSize sets the dimensions of the component
 func (m *BranchSelectorModel) SetSize(width, height int) {
 	m.width = width
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -33,6 +34,9 @@ type InputFormModel struct {
 	keyMap         InputFormKeyMap
 	jiraClient     jira.JiraClient
 	
+	// Tickets of recently created branches, picked with the keys 1-9
+	recentTickets  []jira.Ticket
+	
 	// Validation state
 	ticketValid    bool
 	ticketError    string
@@ -151,6 +155,14 @@ func (m InputFormModel) Update(msg tea.Msg) (InputFormModel, tea.Cmd) {
 			return m, nil
 		}
 
+		// A digit in the empty ticket field picks a recent ticket
+		if index, ok := m.recentTicketIndex(msg); ok {
+			if index < len(m.recentTickets) {
+				return m, m.pickRecentTicket(index)
+			}
+			return m, nil
+		}
+
 		// Update the active input field
 		switch m.currentField {
 		case FieldTicketNumber:
@@ -227,6 +239,38 @@ func (m InputFormModel) fetchTitleCmd(ticketID string) tea.Cmd {
 	}
 }
 
+// maxRecentTickets is the number of recent tickets offered, one per digit key
+const maxRecentTickets = 9
+
+// recentTicketIndex returns the index of the recent ticket picked by a digit key
+// Digits only pick tickets while the list is shown, as ticket numbers never start with one
+func (m InputFormModel) recentTicketIndex(msg tea.KeyMsg) (int, bool) {
+	if !m.showRecentTickets() {
+		return 0, false
+	}
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 || msg.Runes[0] < '1' || msg.Runes[0] > '9' {
+		return 0, false
+	}
+	return int(msg.Runes[0] - '1'), true
+}
+
+// pickRecentTicket fills the form with a recent ticket and moves to the title field
+// The title is fetched from Jira when the history has none
+func (m *InputFormModel) pickRecentTicket(index int) tea.Cmd {
+	ticket := m.recentTickets[index]
+	m.SetTicketNumber(ticket.Key)
+	m.titleInput.SetValue(ticket.Summary)
+	m.titleFetched = false
+	m.titleError = ""
+	m.FocusTitleField()
+
+	if ticket.Summary == "" && m.ticketValid && m.IsJiraAvailable() {
+		m.titleFetching = true
+		return m.fetchTitleCmd(ticket.Key)
+	}
+	return nil
+}
+
 // validateTicketNumber validates the ticket number format
 func (m *InputFormModel) validateTicketNumber(value string) {
 	value = strings.TrimSpace(value)
@@ -294,6 +338,11 @@ func (m InputFormModel) View() string {
 	ticketSection := m.renderTicketField()
 	sections = append(sections, ticketSection)
 
+	// Recent tickets, while the ticket field is empty
+	if recent := m.renderRecentTickets(); recent != "" {
+		sections = append(sections, recent)
+	}
+
 	// Title field
 	titleSection := m.renderTitleField()
 	sections = append(sections, titleSection)
@@ -351,6 +400,28 @@ func (m InputFormModel) renderTicketField() string {
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
+// renderRecentTickets renders the recent tickets with the digit keys that pick them
+func (m InputFormModel) renderRecentTickets() string {
+	if !m.showRecentTickets() {
+		return ""
+	}
+
+	sections := []string{components.UnselectedStyle.Render("  Recent tickets:")}
+	for i, ticket := range m.recentTickets {
+		line := fmt.Sprintf("  %d %s", i+1, ticket.Key)
+		if ticket.Summary != "" {
+			line += "  " + components.HelpStyle.UnsetMargins().Render(ticket.Summary)
+		}
+		sections = append(sections, components.UnselectedStyle.Render(line))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// showRecentTickets reports whether recent tickets can be picked
+func (m InputFormModel) showRecentTickets() bool {
+	return len(m.recentTickets) > 0 && m.currentField == FieldTicketNumber && m.ticketInput.Value() == ""
+}
+
 // renderTitleField renders the title input field
 func (m InputFormModel) renderTitleField() string {
 	var sections []string
@@ -444,6 +515,9 @@ func (m InputFormModel) renderHelp() string {
 			"tab/↓ next field",
 			"enter submit form",
 		}
+		if m.showRecentTickets() {
+			mainHelp = append(mainHelp, fmt.Sprintf("1-%d recent ticket", len(m.recentTickets)))
+		}
 	case FieldTitle:
 		mainHelp = []string{
 			"type title or leave empty",
@@ -537,6 +611,14 @@ func (m *InputFormModel) SetTicketNumber(ticket string) {
 	m.validateTicketNumber(ticket)
 }
 
+// SetRecentTickets sets the tickets offered for reuse, most recent first; at most nine are kept
+func (m *InputFormModel) SetRecentTickets(tickets []jira.Ticket) {
+	if len(tickets) > maxRecentTickets {
+		tickets = tickets[:maxRecentTickets]
+	}
+	m.recentTickets = tickets
+}
+
 // SetTitle sets the title (for pre-filling)
 func (m *InputFormModel) SetTitle(title string) {
 	m.titleInput.SetValue(title)
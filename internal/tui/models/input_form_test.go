@@ -1,9 +1,12 @@
 package models
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+
+	"jiraflow/internal/jira"
 )
 
 // MockJiraClient for testing
@@ -23,6 +26,18 @@ func (m *MockJiraClient) GetTicketTitle(ticketID string) (string, error) {
 	return "", &MockJiraError{ticketID, "ticket not found"}
 }
 
+func (m *MockJiraClient) GetTicket(ticketID string) (*jira.Ticket, error) {
+	title, err := m.GetTicketTitle(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return &jira.Ticket{Key: ticketID, Summary: title}, nil
+}
+
+func (m *MockJiraClient) SearchTickets(jql string, limit int) ([]jira.Ticket, error) {
+	return nil, nil
+}
+
 func (m *MockJiraClient) IsAvailable() bool {
 	return m.available
 }
@@ -407,6 +422,42 @@ func TestInputFormModel_PreFillingData(t *testing.T) {
 	}
 }
 
+func TestInputFormModel_RecentTickets(t *testing.T) {
+	model := NewInputFormModel(nil)
+	model.SetRecentTickets([]jira.Ticket{
+		{Key: "PROJ-1", Summary: "Add login"},
+		{Key: "PROJ-2"},
+	})
+
+	if view := model.View(); !strings.Contains(view, "Recent tickets") || !strings.Contains(view, "1 PROJ-1") {
+		t.Errorf("Expected the recent tickets in the view, got:\n%s", view)
+	}
+
+	// A digit without a recent ticket is ignored
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}})
+	if model.ticketInput.Value() != "" {
+		t.Errorf("Expected empty ticket input, got '%s'", model.ticketInput.Value())
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
+	if model.ticketInput.Value() != "PROJ-1" || model.titleInput.Value() != "Add login" {
+		t.Errorf("Expected PROJ-1 'Add login', got '%s' '%s'", model.ticketInput.Value(), model.titleInput.Value())
+	}
+	if !model.IsValid() || model.currentField != FieldTitle {
+		t.Error("Expected a valid form with the title field focused after picking a recent ticket")
+	}
+	if strings.Contains(model.View(), "Recent tickets") {
+		t.Error("Expected the recent tickets to be hidden once a ticket is entered")
+	}
+
+	// Digits are typed normally once the field has a value
+	model.FocusTicketField()
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	if model.ticketInput.Value() != "PROJ-12" {
+		t.Errorf("Expected ticket input 'PROJ-12', got '%s'", model.ticketInput.Value())
+	}
+}
+
 func TestInputFormModel_StateQueries(t *testing.T) {
 	model := NewInputFormModel(nil)
 
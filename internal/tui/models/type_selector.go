@@ -19,6 +19,8 @@ type TypeItem struct {
 	key         string
 	displayName string
 	description string
+	icon        string
+	color       string
 	isDefault   bool
 }
 
@@ -30,9 +32,17 @@ func (i TypeItem) FilterValue() string {
 // Title returns the type name for display
 func (i TypeItem) Title() string {
 	if i.isDefault {
-		return components.SelectedStyle.Render(i.displayName + " (default)")
+		return components.SelectedStyle.Render(i.label() + " (default)")
 	}
-	return i.displayName
+	return i.label()
+}
+
+// label returns the type name preceded by its icon, if any
+func (i TypeItem) label() string {
+	if i.icon == "" {
+		return i.displayName
+	}
+	return i.icon + " " + i.displayName
 }
 
 // Description returns the type description
@@ -56,15 +66,18 @@ func (d TypeItemDelegate) Render(w io.Writer, m list.Model, index int, listItem
 	var desc string
 
 	if i.isDefault {
-		str = i.displayName + " (default)"
+		str = i.label() + " (default)"
 	} else {
-		str = i.displayName
+		str = i.label()
 	}
 
 	desc = i.description
 
 	fn := components.UnselectedStyle.Render
 	descFn := components.HelpStyle.Render
+	if i.color != "" {
+		fn = components.UnselectedStyle.Foreground(lipgloss.Color(i.color)).Render
+	}
 	
 	if index == m.Index() {
 		fn = func(s ...string) string {
@@ -121,29 +134,20 @@ func DefaultTypeSelectorKeyMap() TypeSelectorKeyMap {
 }
 
 // NewTypeSelectorModel creates a new type selector model
+// Types are listed in the order configured in type_options; hidden types are left out
 func NewTypeSelectorModel(cfg *config.Config) TypeSelectorModel {
-	// Define descriptions for each branch type
-	typeDescriptions := map[string]string{
-		"feature":  "New features and enhancements",
-		"hotfix":   "Critical bug fixes for production",
-		"refactor": "Code improvements without changing functionality",
-		"support":  "Supporting changes like documentation or tooling",
-	}
-
 	// Convert config branch types to TypeItem
 	var typeItems []TypeItem
 	var listItems []list.Item
 
-	for key, displayName := range cfg.BranchTypes {
-		description := typeDescriptions[key]
-		if description == "" {
-			description = "Custom branch type"
-		}
-
+	for _, key := range cfg.VisibleBranchTypes() {
+		options := cfg.TypeOptionsFor(key)
 		item := TypeItem{
 			key:         key,
-			displayName: displayName,
-			description: description,
+			displayName: cfg.BranchTypes[key],
+			description: options.Description,
+			icon:        options.Icon,
+			color:       options.Color,
 			isDefault:   key == cfg.DefaultBranchType,
 		}
 		typeItems = append(typeItems, item)
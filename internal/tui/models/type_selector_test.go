@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -409,4 +410,36 @@ func TestTypeItem_Methods(t *testing.T) {
 	if nonDefaultTitle != "hotfix" {
 		t.Errorf("Expected non-default title to be 'hotfix', got %s", nonDefaultTitle)
 	}
-}
\ No newline at end of file
+}
+
+func TestNewTypeSelectorModel_TypeOptions(t *testing.T) {
+	cfg := config.GetDefaultConfig()
+	cfg.BranchTypes["bugfix"] = "bugfix"
+	cfg.BranchTypes["chore"] = "chore"
+	cfg.TypeOptions = map[string]config.TypeOptions{
+		"bugfix":  {Description: "Non-critical bug fixes", Order: 15, Icon: "🐛", Color: "#ff8700"},
+		"support": {Hidden: true},
+	}
+
+	model := NewTypeSelectorModel(cfg)
+
+	var keys []string
+	for _, item := range model.GetAvailableTypes() {
+		keys = append(keys, item.key)
+	}
+	want := []string{"feature", "bugfix", "hotfix", "refactor", "chore"}
+	if strings.Join(keys, ",") != strings.Join(want, ",") {
+		t.Errorf("type order = %v, want %v", keys, want)
+	}
+
+	bugfix := model.GetAvailableTypes()[1]
+	if bugfix.Description() != "Non-critical bug fixes" || bugfix.Title() != "🐛 bugfix" {
+		t.Errorf("bugfix item = %q / %q", bugfix.Title(), bugfix.Description())
+	}
+	if chore := model.GetAvailableTypes()[4]; chore.Description() != config.DefaultTypeDescription {
+		t.Errorf("chore description = %q, want default", chore.Description())
+	}
+	if feature := model.GetAvailableTypes()[0]; feature.Description() != "New features and enhancements" {
+		t.Errorf("feature description = %q, want built-in description", feature.Description())
+	}
+}
@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+	"jiraflow/internal/tui/models"
+)
+
+// pickerModel wraps the branch selector for standalone branch picking
+type pickerModel struct {
+	selector  models.BranchSelectorModel
+	cancelled bool
+}
+
+// Init initializes the picker
+func (m pickerModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles picker events
+func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.selector.SetSize(msg.Width, msg.Height-2)
+		return m, nil
+
+	case tea.KeyMsg:
+		// Quit and back only apply outside of search mode, where they are plain text
+		if !m.selector.IsSearching() && (key.Matches(msg, keys.Quit) || key.Matches(msg, keys.Back)) {
+			m.cancelled = true
+			return m, tea.Quit
+		}
+		if msg.String() == "ctrl+c" {
+			m.cancelled = true
+			return m, tea.Quit
+		}
+
+		var cmd tea.Cmd
+		m.selector, cmd = m.selector.Update(msg)
+		if m.selector.HasSelection() {
+			return m, tea.Quit
+		}
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// View renders the picker
+func (m pickerModel) View() string {
+	return m.selector.View()
+}
+
+// RunBranchPicker lets the user pick one of the given branches and returns its name
+func RunBranchPicker(title string, branches []git.BranchInfo) (string, error) {
+	selector := models.NewBranchSelectorModel(branches)
+	selector.SetTitle(title)
+
+	p := tea.NewProgram(pickerModel{selector: selector}, tea.WithAltScreen())
+
+	finalModel, err := p.Run()
+	if err != nil {
+		return "", errors.NewTUIError("program", err.Error(), false)
+	}
+
+	picker, ok := finalModel.(pickerModel)
+	if !ok || picker.cancelled || !picker.selector.HasSelection() {
+		return "", errors.ErrCancelled
+	}
+
+	return picker.selector.GetSelected(), nil
+}
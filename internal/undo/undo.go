@@ -0,0 +1,300 @@
+package undo
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"jiraflow/internal/errors"
+	"jiraflow/internal/git"
+)
+
+// recordFile is the file in the Git directory that records the last operation
+const recordFile = "jiraflow-undo.json"
+
+// Operation records what the last JiraFlow command changed in a repository so that it can be undone
+type Operation struct {
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`
+	// PreviousBranch is the branch checked out before the operation, empty for a detached HEAD
+	PreviousBranch string `json:"previous_branch,omitempty"`
+	// PreviousHead is the commit checked out before the operation
+	PreviousHead string   `json:"previous_head"`
+	Branches     []Branch `json:"branches"`
+	// Stash holds uncommitted changes while undo switches branches, until they are re-applied
+	Stash string `json:"stash,omitempty"`
+}
+
+// Branch is a branch created by an operation
+type Branch struct {
+	Name string `json:"name"`
+	Base string `json:"base"`
+	// Head is the commit the branch pointed to when it was created
+	Head string `json:"head"`
+}
+
+// Begin captures the checked out branch before an operation changes it
+func Begin(repo git.GitRepository, command string) Operation {
+	op := Operation{Command: command}
+	op.PreviousBranch, _ = repo.GetCurrentBranch()
+	op.PreviousHead, _ = repo.ResolveRef("HEAD")
+	return op
+}
+
+// AddBranch records a branch created by the operation with the commit it points to
+func (op *Operation) AddBranch(repo git.GitRepository, name, base string) {
+	head, _ := repo.ResolveRef("refs/heads/" + name)
+	op.Branches = append(op.Branches, Branch{Name: name, Base: base, Head: head})
+}
+
+// Previous returns the branch, or for a detached HEAD the commit, checked out before the operation
+func (op Operation) Previous() string {
+	if op.PreviousBranch != "" {
+		return op.PreviousBranch
+	}
+	return op.PreviousHead
+}
+
+// recordPath returns the file the last operation of the repository is recorded in
+func recordPath(repo git.GitRepository) (string, error) {
+	return repo.GetGitPath(recordFile)
+}
+
+// Record saves the operation as the last operation of the repository, replacing the previous one
+// Operations without created branches are not recorded
+func Record(repo git.GitRepository, op Operation) error {
+	if len(op.Branches) == 0 {
+		return nil
+	}
+	if op.Time.IsZero() {
+		op.Time = time.Now()
+	}
+
+	path, err := recordPath(repo)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(op, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Load returns the last operation of the repository, or nil if there is nothing to undo
+func Load(repo git.GitRepository) (*Operation, error) {
+	path, err := recordPath(repo)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var op Operation
+	if err := json.Unmarshal(data, &op); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return &op, nil
+}
+
+// Clear forgets the last operation of the repository
+func Clear(repo git.GitRepository) error {
+	path, err := recordPath(repo)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// ActionKind is a step of undoing an operation
+type ActionKind string
+
+const (
+	ActionCheckout     ActionKind = "checkout"
+	ActionDeleteRemote ActionKind = "delete-remote"
+	ActionDeleteBranch ActionKind = "delete-branch"
+	ActionApplyStash   ActionKind = "apply-stash"
+)
+
+// Action is a step of undoing an operation
+type Action struct {
+	Kind   ActionKind `json:"kind"`
+	Target string     `json:"target"`
+	Remote string     `json:"remote,omitempty"`
+}
+
+// String describes the action
+func (a Action) String() string {
+	switch a.Kind {
+	case ActionCheckout:
+		return fmt.Sprintf("switch back to '%s'", a.Target)
+	case ActionDeleteRemote:
+		return fmt.Sprintf("delete '%s' on '%s'", a.Target, a.Remote)
+	case ActionDeleteBranch:
+		return fmt.Sprintf("delete branch '%s'", a.Target)
+	default:
+		return fmt.Sprintf("re-apply stashed changes %s", a.Target)
+	}
+}
+
+// Options controls how an operation is undone
+type Options struct {
+	// DeleteRemote also deletes pushed branches on their remote
+	DeleteRemote bool
+	// Force deletes branches even if they have commits made after the operation
+	Force bool
+}
+
+// Plan lists the actions that undo the operation, in order, and notes about what is left alone
+// Branches with new commits make planning fail unless Force is set, before anything is changed
+func Plan(repo git.GitRepository, op Operation, opts Options) ([]Action, []string, error) {
+	var actions []Action
+	var notes []string
+
+	current, _ := repo.GetCurrentBranch()
+	checkout := false
+
+	var deletions []Action
+	for _, branch := range op.Branches {
+		head, err := repo.ResolveRef("refs/heads/" + branch.Name)
+		if err != nil {
+			notes = append(notes, fmt.Sprintf("branch '%s' no longer exists", branch.Name))
+			continue
+		}
+		if head != branch.Head && !opts.Force {
+			return nil, nil, errors.NewGitError("undo", fmt.Sprintf("branch '%s' has commits made after it was created; pass --force to delete it anyway", branch.Name), true)
+		}
+
+		if remote := pushedTo(repo, branch.Name); remote != "" {
+			switch {
+			case isBase(repo, branch, remote):
+				notes = append(notes, fmt.Sprintf("'%s' on '%s' is the base of the branch and is left alone", branch.Name, remote))
+			case opts.DeleteRemote:
+				deletions = append(deletions, Action{Kind: ActionDeleteRemote, Target: branch.Name, Remote: remote})
+			default:
+				notes = append(notes, fmt.Sprintf("'%s' was pushed to '%s'; pass --remote to delete it there too", branch.Name, remote))
+			}
+		}
+		deletions = append(deletions, Action{Kind: ActionDeleteBranch, Target: branch.Name})
+		checkout = checkout || branch.Name == current
+	}
+
+	if checkout {
+		if op.Previous() == "" {
+			return nil, nil, errors.NewGitError("undo", "the branch checked out before the operation is unknown", false)
+		}
+		if _, err := repo.ResolveRef(op.Previous()); err != nil {
+			return nil, nil, errors.NewGitError("undo", fmt.Sprintf("'%s', checked out before the operation, no longer exists", op.Previous()), false)
+		}
+		actions = append(actions, Action{Kind: ActionCheckout, Target: op.Previous()})
+	}
+	actions = append(actions, deletions...)
+
+	if op.Stash != "" {
+		actions = append(actions, Action{Kind: ActionApplyStash, Target: op.Stash})
+	}
+
+	return actions, notes, nil
+}
+
+// pushedTo returns the remote of the branch if a branch of the same name exists there, otherwise ""
+// The upstream itself is not used, as a branch created from a remote branch tracks its base
+func pushedTo(repo git.GitRepository, name string) string {
+	remote, _, err := repo.GetUpstream(name)
+	if err != nil {
+		return ""
+	}
+	if _, err := repo.ResolveRef("refs/remotes/" + remote + "/" + name); err != nil {
+		return ""
+	}
+	return remote
+}
+
+// isBase reports whether the branch of the same name on the remote is the base the branch was created from
+func isBase(repo git.GitRepository, branch Branch, remote string) bool {
+	bases := []string{branch.Base}
+	if base, err := repo.GetBranchBase(branch.Name); err == nil {
+		bases = append(bases, base)
+	}
+
+	for _, base := range bases {
+		base = strings.TrimPrefix(strings.TrimPrefix(base, "refs/remotes/"), "refs/heads/")
+		if base == branch.Name || base == remote+"/"+branch.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// Run performs the planned actions and forgets the operation once it is undone
+// Uncommitted changes are carried over when switching back; while switching they are
+// kept in a stash that is recorded, so that running undo again re-applies them after a failure
+func Run(repo git.GitRepository, op Operation, actions []Action) error {
+	for _, action := range actions {
+		var err error
+		switch action.Kind {
+		case ActionCheckout:
+			err = checkoutKeepingChanges(repo, &op, action.Target)
+		case ActionDeleteRemote:
+			err = repo.DeleteRemoteBranch(action.Remote, action.Target)
+		case ActionDeleteBranch:
+			err = repo.DeleteBranch(action.Target)
+		case ActionApplyStash:
+			if err = repo.PopStash(action.Target); err == nil {
+				op.Stash = ""
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return Clear(repo)
+}
+
+// checkoutKeepingChanges switches to the target and re-applies the uncommitted changes there
+func checkoutKeepingChanges(repo git.GitRepository, op *Operation, target string) error {
+	dirty, err := repo.HasUncommittedChanges()
+	if err != nil {
+		return err
+	}
+	if !dirty {
+		return repo.CheckoutBranch(target)
+	}
+
+	stash, err := repo.Stash("jiraflow undo")
+	if err != nil {
+		return err
+	}
+	op.Stash = stash
+	_ = Record(repo, *op)
+
+	if err := repo.CheckoutBranch(target); err != nil {
+		if repo.PopStash(stash) == nil {
+			op.Stash = ""
+			_ = Record(repo, *op)
+		}
+		return err
+	}
+
+	if err := repo.PopStash(stash); err != nil {
+		return errors.NewGitError("stash", fmt.Sprintf("switched to '%s' but your changes could not be re-applied; they are kept in stash %s: %v", target, stash, err), true)
+	}
+	op.Stash = ""
+	_ = Record(repo, *op)
+	return nil
+}
@@ -0,0 +1,260 @@
+package undo
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"jiraflow/internal/git"
+)
+
+// initTestRepo creates a repository with one commit on main in a temporary directory and changes into it
+func initTestRepo(t *testing.T) *git.LocalGitRepository {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("Skipping test: git not installed")
+	}
+
+	t.Chdir(t.TempDir())
+	runGit(t, "init", "-q", "-b", "main")
+	runGit(t, "config", "user.email", "test@example.com")
+	runGit(t, "config", "user.name", "Test")
+	runGit(t, "config", "commit.gpgsign", "false")
+	if err := os.WriteFile("file.txt", []byte("one\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, "add", "file.txt")
+	runGit(t, "commit", "-q", "-m", "initial")
+	return git.NewLocalGitRepository()
+}
+
+// runGit runs a git command in the current directory and fails the test on error
+func runGit(t *testing.T, args ...string) string {
+	t.Helper()
+
+	output, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// createBranch creates and checks out a branch from main and records the operation
+func createBranch(t *testing.T, repo *git.LocalGitRepository, name string) Operation {
+	t.Helper()
+
+	op := Begin(repo, "create")
+	if err := repo.CreateBranch(name, "main"); err != nil {
+		t.Fatalf("CreateBranch() unexpected error: %v", err)
+	}
+	op.AddBranch(repo, name, "main")
+	if err := Record(repo, op); err != nil {
+		t.Fatalf("Record() unexpected error: %v", err)
+	}
+	return op
+}
+
+func TestUndo_CreatedBranch(t *testing.T) {
+	repo := initTestRepo(t)
+
+	if op, err := Load(repo); err != nil || op != nil {
+		t.Fatalf("Load() without a recorded operation = %v, %v, want nil", op, err)
+	}
+
+	createBranch(t, repo, "feature/PROJ-1")
+
+	op, err := Load(repo)
+	if err != nil || op == nil {
+		t.Fatalf("Load() = %v, %v, want the recorded operation", op, err)
+	}
+	if op.PreviousBranch != "main" || len(op.Branches) != 1 || op.Branches[0].Head == "" {
+		t.Errorf("Load() = %+v, want previous branch main and one created branch", op)
+	}
+
+	actions, notes, err := Plan(repo, *op, Options{})
+	if err != nil {
+		t.Fatalf("Plan() unexpected error: %v", err)
+	}
+	want := []Action{
+		{Kind: ActionCheckout, Target: "main"},
+		{Kind: ActionDeleteBranch, Target: "feature/PROJ-1"},
+	}
+	if len(actions) != len(want) || actions[0] != want[0] || actions[1] != want[1] || len(notes) != 0 {
+		t.Fatalf("Plan() = %v, %v, want %v", actions, notes, want)
+	}
+
+	if err := Run(repo, *op, actions); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if current, _ := repo.GetCurrentBranch(); current != "main" {
+		t.Errorf("current branch after undo = %q, want main", current)
+	}
+	if _, err := repo.ResolveRef("refs/heads/feature/PROJ-1"); err == nil {
+		t.Error("created branch still exists after undo")
+	}
+	if op, _ := Load(repo); op != nil {
+		t.Errorf("Load() after undo = %+v, want nil", op)
+	}
+}
+
+func TestUndo_KeepsUncommittedChanges(t *testing.T) {
+	repo := initTestRepo(t)
+	op := createBranch(t, repo, "feature/PROJ-1")
+
+	if err := os.WriteFile("file.txt", []byte("two\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	actions, _, err := Plan(repo, op, Options{})
+	if err != nil {
+		t.Fatalf("Plan() unexpected error: %v", err)
+	}
+	if err := Run(repo, op, actions); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+
+	if content, _ := os.ReadFile("file.txt"); string(content) != "two\n" {
+		t.Errorf("file content after undo = %q, want the uncommitted change", content)
+	}
+	if list := runGit(t, "stash", "list"); list != "" {
+		t.Errorf("stash list after undo = %q, want empty", list)
+	}
+}
+
+func TestUndo_BranchWithNewCommits(t *testing.T) {
+	repo := initTestRepo(t)
+	op := createBranch(t, repo, "feature/PROJ-1")
+	runGit(t, "commit", "-q", "--allow-empty", "-m", "work")
+
+	if _, _, err := Plan(repo, op, Options{}); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("Plan() error = %v, want an error suggesting --force", err)
+	}
+
+	actions, _, err := Plan(repo, op, Options{Force: true})
+	if err != nil {
+		t.Fatalf("Plan(Force) unexpected error: %v", err)
+	}
+	if len(actions) != 2 {
+		t.Errorf("Plan(Force) = %v, want checkout and delete", actions)
+	}
+}
+
+func TestUndo_PushedBranch(t *testing.T) {
+	repo := initTestRepo(t)
+	remote := t.TempDir()
+	runGit(t, "init", "-q", "--bare", remote)
+	runGit(t, "remote", "add", "origin", remote)
+
+	op := createBranch(t, repo, "feature/PROJ-1")
+	runGit(t, "push", "-q", "-u", "origin", "feature/PROJ-1")
+
+	actions, notes, err := Plan(repo, op, Options{})
+	if err != nil {
+		t.Fatalf("Plan() unexpected error: %v", err)
+	}
+	if len(actions) != 2 || len(notes) != 1 || !strings.Contains(notes[0], "--remote") {
+		t.Errorf("Plan() = %v, %v, want the remote branch left alone with a note", actions, notes)
+	}
+
+	actions, _, err = Plan(repo, op, Options{DeleteRemote: true})
+	if err != nil {
+		t.Fatalf("Plan(DeleteRemote) unexpected error: %v", err)
+	}
+	if err := Run(repo, op, actions); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if branches := runGit(t, "ls-remote", "--heads", "origin"); branches != "" {
+		t.Errorf("remote branches after undo = %q, want none", branches)
+	}
+}
+
+func TestPlan_BranchCreatedWithoutCheckout(t *testing.T) {
+	repo := initTestRepo(t)
+
+	op := Begin(repo, "batch")
+	for _, name := range []string{"feature/PROJ-1", "feature/PROJ-2"} {
+		if err := repo.CreateBranchWithoutCheckout(name, "main"); err != nil {
+			t.Fatal(err)
+		}
+		op.AddBranch(repo, name, "main")
+	}
+	runGit(t, "branch", "-D", "feature/PROJ-2")
+
+	actions, notes, err := Plan(repo, op, Options{})
+	if err != nil {
+		t.Fatalf("Plan() unexpected error: %v", err)
+	}
+	if len(actions) != 1 || actions[0] != (Action{Kind: ActionDeleteBranch, Target: "feature/PROJ-1"}) {
+		t.Errorf("Plan() = %v, want only the deletion of feature/PROJ-1", actions)
+	}
+	if len(notes) != 1 || !strings.Contains(notes[0], "feature/PROJ-2") {
+		t.Errorf("Plan() notes = %v, want a note about the missing branch", notes)
+	}
+}
+
+func TestUndo_RemoteBase(t *testing.T) {
+	repo := initTestRepo(t)
+	remote := t.TempDir()
+	runGit(t, "init", "-q", "--bare", remote)
+	runGit(t, "remote", "add", "origin", remote)
+	runGit(t, "push", "-q", "origin", "main")
+
+	op := Begin(repo, "create")
+	if err := repo.CreateBranch("feature/PROJ-1", "origin/main"); err != nil {
+		t.Fatalf("CreateBranch() unexpected error: %v", err)
+	}
+	runGit(t, "branch", "--set-upstream-to", "origin/main")
+	op.AddBranch(repo, "feature/PROJ-1", "origin/main")
+
+	actions, notes, err := Plan(repo, op, Options{DeleteRemote: true})
+	if err != nil {
+		t.Fatalf("Plan() unexpected error: %v", err)
+	}
+	for _, action := range actions {
+		if action.Kind == ActionDeleteRemote {
+			t.Errorf("Plan() = %v, want no remote deletion for a branch that was not pushed", actions)
+		}
+	}
+	if len(notes) != 0 {
+		t.Errorf("Plan() notes = %v, want none", notes)
+	}
+
+	if err := Run(repo, op, actions); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if branches := runGit(t, "ls-remote", "--heads", "origin"); !strings.HasSuffix(branches, "refs/heads/main") {
+		t.Errorf("remote branches after undo = %q, want main", branches)
+	}
+}
+
+func TestPlan_BranchNamedLikeItsBase(t *testing.T) {
+	repo := initTestRepo(t)
+	remote := t.TempDir()
+	runGit(t, "init", "-q", "--bare", remote)
+	runGit(t, "remote", "add", "origin", remote)
+	runGit(t, "push", "-q", "origin", "main")
+	runGit(t, "checkout", "-q", "--detach")
+	runGit(t, "branch", "-D", "main")
+
+	op := Begin(repo, "create")
+	if err := repo.CreateBranch("main", "origin/main"); err != nil {
+		t.Fatalf("CreateBranch() unexpected error: %v", err)
+	}
+	runGit(t, "branch", "--set-upstream-to", "origin/main")
+	op.AddBranch(repo, "main", "origin/main")
+
+	actions, notes, err := Plan(repo, op, Options{DeleteRemote: true})
+	if err != nil {
+		t.Fatalf("Plan() unexpected error: %v", err)
+	}
+	for _, action := range actions {
+		if action.Kind == ActionDeleteRemote {
+			t.Errorf("Plan() = %v, want the base on the remote left alone", actions)
+		}
+	}
+	if len(notes) != 1 || !strings.Contains(notes[0], "base") {
+		t.Errorf("Plan() notes = %v, want a note that the base is left alone", notes)
+	}
+}